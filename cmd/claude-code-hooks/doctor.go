@@ -0,0 +1,164 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/michael-freling/claude-code-tools/internal/command"
+	"github.com/michael-freling/claude-code-tools/internal/hooks"
+	"github.com/spf13/cobra"
+)
+
+// doctorCheck is a command run by the doctor command to check the environment.
+type doctorCheck struct {
+	name      string
+	required  bool
+	dependsOn string // Name of a check that must pass before this check is run
+	command   string
+	args      []string
+	fix       string
+}
+
+// doctorChecks returns the checks for the tools used by the hook rules.
+func doctorChecks() []doctorCheck {
+	return []doctorCheck{
+		{
+			name:     "git",
+			required: true,
+			command:  "git",
+			args:     []string{"--version"},
+			fix:      "Install git: https://git-scm.com/downloads",
+		},
+		{
+			name:     "gh",
+			required: true,
+			command:  "gh",
+			args:     []string{"--version"},
+			fix:      "Install the GitHub CLI: https://cli.github.com",
+		},
+		{
+			name:      "gh auth",
+			required:  true,
+			dependsOn: "gh",
+			command:   "gh",
+			args:      []string{"auth", "status"},
+			fix:       "Run: gh auth login",
+		},
+		{
+			name:     "claude",
+			required: false,
+			command:  "claude",
+			args:     []string{"--version"},
+			fix:      "Install Claude Code: https://docs.anthropic.com/en/docs/claude-code",
+		},
+		{
+			name:      "claude auth",
+			required:  false,
+			dependsOn: "claude",
+			command:   "claude",
+			args:      []string{"auth", "status", "--text"},
+			fix:       "Run: claude auth login",
+		},
+	}
+}
+
+func newDoctorCmd() *cobra.Command {
+	var configPath string
+
+	cmd := &cobra.Command{
+		Use:   "doctor",
+		Short: "Check the environment required by the hook rules",
+		Long: `Checks that git, gh (including its authentication), and the Claude CLI (including its login) are available and that the hooks config is valid.
+Prints a fix for each failed check and returns a non-zero exit code if a required check fails.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if configPath == "" {
+				configPath = defaultConfigPath()
+			}
+
+			return runDoctor(cmd.Context(), cmd.OutOrStdout(), command.NewRunner(), configPath)
+		},
+	}
+
+	cmd.Flags().StringVar(&configPath, "config", "", "Path to the hooks config file (default: $CLAUDE_PROJECT_DIR/.claude/hooks.yaml)")
+
+	return cmd
+}
+
+// runDoctor runs all checks and writes a report to out.
+// Returns an error listing the required checks that failed.
+func runDoctor(ctx context.Context, out io.Writer, runner command.Runner, configPath string) error {
+	var failedRequired []string
+	passed := make(map[string]bool)
+
+	for _, check := range doctorChecks() {
+		if check.dependsOn != "" && !passed[check.dependsOn] {
+			fmt.Fprintf(out, "[skip] %s: %s is not available\n", check.name, check.dependsOn)
+			if check.required {
+				failedRequired = append(failedRequired, check.name)
+			}
+			continue
+		}
+
+		stdout, stderr, err := runner.Run(ctx, check.command, check.args...)
+		if err == nil {
+			passed[check.name] = true
+			fmt.Fprintf(out, "[ok]   %s: %s\n", check.name, firstLine(stdout, stderr))
+			continue
+		}
+
+		status := "[warn]"
+		if check.required {
+			status = "[fail]"
+			failedRequired = append(failedRequired, check.name)
+		}
+
+		detail := firstLine(stderr, stdout, err.Error())
+		fmt.Fprintf(out, "%s %s: %s\n", status, check.name, detail)
+		fmt.Fprintf(out, "       fix: %s\n", check.fix)
+	}
+
+	if err := checkConfig(configPath, runner); err != nil {
+		failedRequired = append(failedRequired, "config")
+		fmt.Fprintf(out, "[fail] config: %v\n", err)
+		fmt.Fprintf(out, "       fix: Correct the settings in %s\n", configPath)
+	} else {
+		fmt.Fprintf(out, "[ok]   config: %s\n", configPath)
+	}
+
+	if len(failedRequired) > 0 {
+		return fmt.Errorf("required checks failed: %s", strings.Join(failedRequired, ", "))
+	}
+
+	return nil
+}
+
+// checkConfig loads the hooks config and builds its rules to validate the settings.
+func checkConfig(configPath string, runner command.Runner) error {
+	config, err := hooks.LoadConfig(configPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := hooks.NewRulesFromConfig(config, command.NewGitRunner(runner), command.NewGhRunner(runner)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// firstLine returns the first line of the first non-empty value.
+func firstLine(values ...string) string {
+	for _, value := range values {
+		value = strings.TrimSpace(value)
+		if value == "" {
+			continue
+		}
+		line, _, _ := strings.Cut(value, "\n")
+		return line
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/michael-freling/claude-code-tools/internal/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewDoctorCmd(t *testing.T) {
+	cmd := newDoctorCmd()
+
+	assert.Equal(t, "doctor", cmd.Use)
+	assert.NotEmpty(t, cmd.Short)
+	assert.NotEmpty(t, cmd.Long)
+	assert.NotNil(t, cmd.RunE)
+	assert.NotNil(t, cmd.Flags().Lookup("config"))
+
+	err := cmd.Args(cmd, []string{"extra"})
+	assert.Error(t, err)
+}
+
+func TestRunDoctor(t *testing.T) {
+	errNotFound := errors.New(`exec: "gh": executable file not found in $PATH`)
+
+	tests := []struct {
+		name         string
+		config       string
+		setupMock    func(m *command.MockRunner)
+		wantErr      bool
+		errContains  string
+		wantContains []string
+	}{
+		{
+			name: "all checks pass",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("gh version 2.40.0 (2023-12-07)\nhttps://github.com/cli/cli/releases/tag/v2.40.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "auth", "status").Return("", "github.com\n  Logged in to github.com", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("1.0.0 (Claude Code)", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "auth", "status", "--text").Return("Logged in as user@example.com", "", nil)
+			},
+			wantContains: []string{
+				"[ok]   git: git version 2.43.0",
+				"[ok]   gh: gh version 2.40.0 (2023-12-07)",
+				"[ok]   gh auth: github.com",
+				"[ok]   claude: 1.0.0 (Claude Code)",
+				"[ok]   claude auth: Logged in as user@example.com",
+				"[ok]   config:",
+			},
+		},
+		{
+			name: "missing gh fails and skips auth check",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("", "", errNotFound)
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("1.0.0 (Claude Code)", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "auth", "status", "--text").Return("Logged in as user@example.com", "", nil)
+			},
+			wantErr:     true,
+			errContains: "required checks failed: gh, gh auth",
+			wantContains: []string{
+				`[fail] gh: exec: "gh": executable file not found in $PATH`,
+				"       fix: Install the GitHub CLI: https://cli.github.com",
+				"[skip] gh auth: gh is not available",
+			},
+		},
+		{
+			name: "unauthenticated gh fails",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("gh version 2.40.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "auth", "status").Return("", "You are not logged into any GitHub hosts. Run gh auth login to authenticate.", errors.New("exit status 1"))
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("1.0.0 (Claude Code)", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "auth", "status", "--text").Return("Logged in as user@example.com", "", nil)
+			},
+			wantErr:     true,
+			errContains: "required checks failed: gh auth",
+			wantContains: []string{
+				"[fail] gh auth: You are not logged into any GitHub hosts. Run gh auth login to authenticate.",
+				"       fix: Run: gh auth login",
+			},
+		},
+		{
+			name: "missing claude only warns",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("gh version 2.40.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "auth", "status").Return("Logged in to github.com", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("", "", errors.New("executable file not found"))
+			},
+			wantContains: []string{
+				"[warn] claude: executable file not found",
+				"[skip] claude auth: claude is not available",
+			},
+		},
+		{
+			name: "claude not logged in only warns",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("gh version 2.40.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "auth", "status").Return("Logged in to github.com", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("1.0.0 (Claude Code)", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "auth", "status", "--text").Return("Not logged in. Run claude auth login to authenticate.", "", errors.New("exit status 1"))
+			},
+			wantContains: []string{
+				"[warn] claude auth: Not logged in. Run claude auth login to authenticate.",
+				"       fix: Run: claude auth login",
+			},
+		},
+		{
+			name:   "invalid config fails",
+			config: "rules:\n  unknown-rule: {}\n",
+			setupMock: func(m *command.MockRunner) {
+				m.EXPECT().Run(gomock.Any(), "git", "--version").Return("git version 2.43.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "--version").Return("gh version 2.40.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "gh", "auth", "status").Return("Logged in to github.com", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "--version").Return("1.0.0", "", nil)
+				m.EXPECT().Run(gomock.Any(), "claude", "auth", "status", "--text").Return("Logged in as user@example.com", "", nil)
+			},
+			wantErr:     true,
+			errContains: "required checks failed: config",
+			wantContains: []string{
+				"[fail] config: unknown rules in config: [unknown-rule]",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := command.NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			configPath := filepath.Join(t.TempDir(), "hooks.yaml")
+			if tt.config != "" {
+				require.NoError(t, os.WriteFile(configPath, []byte(tt.config), 0644))
+			}
+
+			out := new(bytes.Buffer)
+			err := runDoctor(context.Background(), out, mockRunner, configPath)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for _, want := range tt.wantContains {
+				assert.Contains(t, out.String(), want)
+			}
+		})
+	}
+}
@@ -1,20 +1,56 @@
 package main
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/michael-freling/claude-code-tools/internal/command"
 	"github.com/michael-freling/claude-code-tools/internal/hooks"
 	"github.com/spf13/cobra"
 )
 
+// blockExitCode is the exit code that makes Claude Code block the tool call and show stderr to Claude.
+// Any other non-zero exit code is a non-blocking error, so the tool call proceeds.
+const blockExitCode = 2
+
+// exitCodeError is returned by a command to exit with a specific code.
+// Its message has already been printed by the command.
+type exitCodeError struct {
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
 func main() {
 	if err := newRootCmd().Execute(); err != nil {
+		var exitErr *exitCodeError
+		if errors.As(err, &exitErr) {
+			os.Exit(exitErr.code)
+		}
 		os.Exit(1)
 	}
 }
 
+// blockToolUse prints the message to stderr and returns an error exiting with blockExitCode.
+func blockToolUse(cmd *cobra.Command, format string, args ...any) error {
+	cmd.SilenceErrors = true
+	fmt.Fprintf(cmd.ErrOrStderr(), format+"\n", args...)
+	return &exitCodeError{code: blockExitCode}
+}
+
+// hookOutput is the JSON output of a hook that allows a tool call.
+// Claude Code only shows stderr of a successful hook in verbose mode, so messages are written here instead.
+type hookOutput struct {
+	SystemMessage string `json:"systemMessage,omitempty"`
+}
+
 func newRootCmd() *cobra.Command {
 	rootCmd := &cobra.Command{
 		Use:   "claude-hooks",
@@ -22,33 +58,69 @@ func newRootCmd() *cobra.Command {
 		Long:  `A CLI tool that provides hook execution for Claude Code, allowing control over which tools can be used and under what conditions.`,
 	}
 
+	rootCmd.AddCommand(newDoctorCmd())
 	rootCmd.AddCommand(newPreToolUseCmd())
 
 	return rootCmd
 }
 
+// defaultConfigPath returns the hooks config path under the Claude Code project directory,
+// falling back to the current directory when CLAUDE_PROJECT_DIR is not set.
+func defaultConfigPath() string {
+	projectDir := os.Getenv("CLAUDE_PROJECT_DIR")
+	if projectDir == "" {
+		return hooks.DefaultConfigPath
+	}
+	return filepath.Join(projectDir, hooks.DefaultConfigPath)
+}
+
 func newPreToolUseCmd() *cobra.Command {
-	return &cobra.Command{
+	var allowedCommands []string
+	var configPath string
+
+	cmd := &cobra.Command{
 		Use:   "pre-tool-use",
 		Short: "Evaluate rules before tool execution",
-		Long:  `Reads tool input from stdin as JSON and evaluates configured rules. Returns exit code 0 to allow, exit code 2 to block.`,
-		Args:  cobra.NoArgs,
+		Long: `Reads tool input from stdin as JSON and evaluates configured rules. Returns exit code 0 to allow, exit code 2 to block.
+Warnings of allowed tool calls are written to stdout as the systemMessage of the hook JSON output.
+
+Rules can be enabled, disabled, or downgraded to warnings in .claude/hooks.yaml.
+An invalid config also blocks, so that a mistake in it does not silently disable the rules.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			toolInput, err := hooks.ParseToolInput(cmd.InOrStdin())
 			if err != nil {
 				return fmt.Errorf("failed to parse tool input: %w", err)
 			}
 
-			runner := command.NewRunner()
+			if configPath == "" {
+				configPath = defaultConfigPath()
+			}
+			config, err := hooks.LoadConfig(configPath)
+			if err != nil {
+				return blockToolUse(cmd, "Failed to load hooks config: %v", err)
+			}
+
+			if len(allowedCommands) > 0 {
+				enabled := true
+				allowlistConfig := config.Rules["command-allowlist"]
+				allowlistConfig.Enabled = &enabled
+				allowlistConfig.AllowedCommands = allowedCommands
+				config.Rules["command-allowlist"] = allowlistConfig
+			}
+
+			// Rules only run read-only git/gh commands, so network failures are safe to retry
+			runner := command.NewRetryRunner(command.NewRunner(), command.RetryOptions{
+				InitialBackoff: 500 * time.Millisecond,
+				MaxBackoff:     2 * time.Second,
+			})
 			gitRunner := command.NewGitRunner(runner)
 			ghRunner := command.NewGhRunner(runner)
 
-			rules := []hooks.Rule{
-				hooks.NewNoVerifyRule(),
-				hooks.NewGitPushRule(gitRunner),
-				hooks.NewBranchProtectionRule(),
-				hooks.NewRulesetRule(),
-				hooks.NewPRMergeRule(ghRunner),
+			rules, err := hooks.NewRulesFromConfig(config, gitRunner, ghRunner)
+			if err != nil {
+				return blockToolUse(cmd, "Failed to build rules from hooks config: %v", err)
 			}
 
 			engine := hooks.NewRuleEngine(rules...)
@@ -58,11 +130,29 @@ func newPreToolUseCmd() *cobra.Command {
 			}
 
 			if !result.Allowed {
-				fmt.Fprintf(cmd.ErrOrStderr(), "Blocked by rule %s: %s\n", result.RuleName, result.Message)
-				os.Exit(2)
+				return blockToolUse(cmd, "Blocked by rule %s: %s", result.RuleName, result.Message)
+			}
+
+			if len(result.Warnings) == 0 {
+				return nil
+			}
+
+			messages := make([]string, 0, len(result.Warnings))
+			for _, warning := range result.Warnings {
+				messages = append(messages, fmt.Sprintf("Warning from rule %s: %s", warning.RuleName, warning.Message))
+			}
+			if err := json.NewEncoder(cmd.OutOrStdout()).Encode(hookOutput{
+				SystemMessage: strings.Join(messages, "\n"),
+			}); err != nil {
+				return fmt.Errorf("failed to write hook output: %w", err)
 			}
 
 			return nil
 		},
 	}
+
+	cmd.Flags().StringSliceVar(&allowedCommands, "allow-command", []string{}, "Only allow Bash commands running these executables (default: no restriction)")
+	cmd.Flags().StringVar(&configPath, "config", "", "Path to the hooks config file (default: $CLAUDE_PROJECT_DIR/.claude/hooks.yaml)")
+
+	return cmd
 }
@@ -2,6 +2,8 @@ package main
 
 import (
 	"bytes"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -9,6 +11,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+// useMissingConfig points the default config path to an empty project directory,
+// so that a test does not depend on $CLAUDE_PROJECT_DIR or a .claude/hooks.yaml in the working directory.
+func useMissingConfig(t *testing.T) {
+	t.Helper()
+	t.Setenv("CLAUDE_PROJECT_DIR", t.TempDir())
+}
+
 func TestNewRootCmd(t *testing.T) {
 	cmd := newRootCmd()
 
@@ -20,10 +29,12 @@ func TestNewRootCmd(t *testing.T) {
 	for _, c := range cmd.Commands() {
 		commandNames = append(commandNames, c.Name())
 	}
-	assert.ElementsMatch(t, []string{"pre-tool-use"}, commandNames)
+	assert.ElementsMatch(t, []string{"doctor", "pre-tool-use"}, commandNames)
 }
 
 func TestNewPreToolUseCmd(t *testing.T) {
+	useMissingConfig(t)
+
 	cmd := newPreToolUseCmd()
 
 	assert.Equal(t, "pre-tool-use", cmd.Use)
@@ -39,6 +50,8 @@ func TestNewPreToolUseCmd(t *testing.T) {
 }
 
 func TestPreToolUseCmd_Execute(t *testing.T) {
+	useMissingConfig(t)
+
 	tests := []struct {
 		name     string
 		input    string
@@ -84,6 +97,8 @@ func TestPreToolUseCmd_Execute(t *testing.T) {
 }
 
 func TestPreToolUseCmd_ExitCodes(t *testing.T) {
+	useMissingConfig(t)
+
 	tests := []struct {
 		name  string
 		input string
@@ -109,6 +124,8 @@ func TestPreToolUseCmd_ExitCodes(t *testing.T) {
 }
 
 func TestPreToolUseCmd_IntegrationAllowedCommands(t *testing.T) {
+	useMissingConfig(t)
+
 	tests := []struct {
 		name  string
 		input string
@@ -159,3 +176,136 @@ func TestPreToolUseCmd_IntegrationAllowedCommands(t *testing.T) {
 		})
 	}
 }
+
+func TestPreToolUseCmd_AllowCommandFlag(t *testing.T) {
+	useMissingConfig(t)
+
+	cmd := newPreToolUseCmd()
+
+	flag := cmd.Flags().Lookup("allow-command")
+	require.NotNil(t, flag)
+	assert.Equal(t, "stringSlice", flag.Value.Type())
+	assert.Equal(t, "[]", flag.DefValue)
+
+	buf := new(bytes.Buffer)
+	cmd.SetOut(buf)
+	cmd.SetErr(buf)
+	cmd.SetIn(strings.NewReader(`{"tool_name": "Bash", "tool_input": {"command": "go test ./... && git status"}}`))
+	cmd.SetArgs([]string{"--allow-command", "go,git"})
+
+	err := cmd.Execute()
+	require.NoError(t, err)
+	assert.Empty(t, buf.String())
+}
+
+func TestPreToolUseCmd_Config(t *testing.T) {
+	tests := []struct {
+		name           string
+		config         string
+		input          string
+		wantExitCode   int
+		wantStdout     string
+		wantStderr     string
+		stderrContains string
+	}{
+		{
+			name: "warn severity allows and prints warning",
+			config: `rules:
+  no-verify:
+    severity: warn
+`,
+			input:      `{"tool_name": "Bash", "tool_input": {"command": "git commit --no-verify -m test"}}`,
+			wantStdout: `{"systemMessage":"Warning from rule no-verify: Command contains --no-verify flag which bypasses git hooks"}` + "\n",
+		},
+		{
+			name: "multiple warnings are joined in one message",
+			config: `rules:
+  no-verify:
+    severity: warn
+pattern_rules:
+  - name: no-commit
+    fields: [command]
+    regex: '\bcommit\b'
+    message: Commits are reviewed
+    severity: warn
+`,
+			input:      `{"tool_name": "Bash", "tool_input": {"command": "git commit --no-verify -m test"}}`,
+			wantStdout: `{"systemMessage":"Warning from rule no-verify: Command contains --no-verify flag which bypasses git hooks\nWarning from rule no-commit: Commits are reviewed"}` + "\n",
+		},
+		{
+			name: "disabled rule allows",
+			config: `rules:
+  no-verify:
+    enabled: false
+`,
+			input: `{"tool_name": "Bash", "tool_input": {"command": "git commit --no-verify -m test"}}`,
+		},
+		{
+			name: "blocked rule exits with block code",
+			config: `rules:
+  no-verify:
+    enabled: true
+`,
+			input:        `{"tool_name": "Bash", "tool_input": {"command": "git commit --no-verify -m test"}}`,
+			wantExitCode: blockExitCode,
+			wantStderr:   "Blocked by rule no-verify: Command contains --no-verify flag which bypasses git hooks\n",
+		},
+		{
+			name: "unknown rule blocks",
+			config: `rules:
+  git-pushh:
+    enabled: true
+`,
+			input:        `{"tool_name": "Bash", "tool_input": {"command": "git push origin main"}}`,
+			wantExitCode: blockExitCode,
+			wantStderr:   "Failed to build rules from hooks config: unknown rules in config: [git-pushh]\n",
+		},
+		{
+			name:           "invalid config blocks",
+			config:         "rules: [invalid",
+			input:          `{"tool_name": "Bash", "tool_input": {"command": "ls"}}`,
+			wantExitCode:   blockExitCode,
+			stderrContains: "Failed to load hooks config: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "hooks.yaml")
+			require.NoError(t, os.WriteFile(configPath, []byte(tt.config), 0644))
+
+			cmd := newPreToolUseCmd()
+			outBuf := new(bytes.Buffer)
+			errBuf := new(bytes.Buffer)
+			cmd.SetOut(outBuf)
+			cmd.SetErr(errBuf)
+			cmd.SetIn(strings.NewReader(tt.input))
+			cmd.SetArgs([]string{"--config", configPath})
+
+			err := cmd.Execute()
+
+			if tt.wantExitCode != 0 {
+				var exitErr *exitCodeError
+				require.ErrorAs(t, err, &exitErr)
+				assert.Equal(t, tt.wantExitCode, exitErr.code)
+			} else {
+				require.NoError(t, err)
+			}
+
+			assert.Equal(t, tt.wantStdout, outBuf.String())
+			if tt.stderrContains != "" {
+				assert.Contains(t, errBuf.String(), tt.stderrContains)
+				return
+			}
+			assert.Equal(t, tt.wantStderr, errBuf.String())
+		})
+	}
+}
+
+func TestDefaultConfigPath(t *testing.T) {
+	t.Setenv("CLAUDE_PROJECT_DIR", "")
+	assert.Equal(t, ".claude/hooks.yaml", defaultConfigPath())
+
+	t.Setenv("CLAUDE_PROJECT_DIR", "/path/to/project")
+	assert.Equal(t, "/path/to/project/.claude/hooks.yaml", defaultConfigPath())
+}
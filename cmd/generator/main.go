@@ -2,6 +2,7 @@ package main
 
 import (
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/michael-freling/claude-code-tools/internal/generator"
@@ -33,9 +34,23 @@ func newRootCmd() *cobra.Command {
 	return rootCmd
 }
 
-func createGenerator() (*generator.Generator, error) {
+// createGenerator creates a generator from --template-dir, or from the default template layers without it.
+// Warnings about skipped files in the project and user template directories are written to warnings.
+func createGenerator(warnings io.Writer) (*generator.Generator, error) {
 	if templateDir == "" {
-		return generator.NewGenerator()
+		// Layers whose base directory cannot be determined are skipped
+		projectDir, _ := os.Getwd()
+		homeDir, _ := os.UserHomeDir()
+		gen, err := generator.NewGeneratorWithLayers(generator.DefaultTemplateLayers(projectDir, homeDir)...)
+		if err != nil {
+			return nil, err
+		}
+
+		// A broken file in a user directory is skipped so that the other templates remain usable
+		for _, warning := range gen.Warnings() {
+			fmt.Fprintf(warnings, "Warning: %s\n", warning)
+		}
+		return gen, nil
 	}
 
 	// Validate that the directory exists and is readable
@@ -55,27 +70,82 @@ func createGenerator() (*generator.Generator, error) {
 	return generator.NewGeneratorWithFS(fsys)
 }
 
+// completeTemplateNames returns a completion function that suggests "list" and
+// the template names available for the given item type.
+// It honors --template-dir so custom templates are completed as well.
+func completeTemplateNames(itemType generator.ItemType) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+
+		// Warnings are not printed as they would be mixed into the shell prompt while completing
+		gen, err := createGenerator(io.Discard)
+		if err != nil {
+			return nil, cobra.ShellCompDirectiveError
+		}
+
+		names := append([]string{"list"}, gen.List(itemType)...)
+		return names, cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// printTemplateList prints the available templates of the given type.
+// Templates that override or extend the embedded templates are annotated with their source.
+func printTemplateList(gen *generator.Generator, itemType generator.ItemType) {
+	for _, name := range gen.List(itemType) {
+		source := gen.Source(itemType, name)
+		if source == "" || source == generator.SourceEmbedded {
+			fmt.Println(name)
+			continue
+		}
+		fmt.Printf("%s (%s)\n", name, source)
+	}
+}
+
+// addOutputFlags adds the flags to write a generated item into a directory.
+func addOutputFlags(cmd *cobra.Command, outputDir *string, force *bool) {
+	cmd.Flags().StringVar(outputDir, "output-dir", "", "Write output to a file in the specified directory")
+	cmd.Flags().BoolVar(force, "force", false, "Overwrite existing files")
+}
+
+// generateItem prints a generated item to stdout, or writes it into outputDir if specified.
+func generateItem(gen *generator.Generator, itemType generator.ItemType, name string, outputDir string, opts generator.WriteOptions) error {
+	if outputDir == "" {
+		return gen.Generate(itemType, name)
+	}
+
+	outputPath, err := gen.GenerateToDir(itemType, name, outputDir, opts)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Created %s\n", outputPath)
+	return nil
+}
+
 func newAgentsCmd() *cobra.Command {
+	var outputDir string
+	var force bool
+
 	cmd := &cobra.Command{
-		Use:   "agents [name|list]",
-		Short: "Generate prompt for a specific agent or list available agents",
-		Long:  `Generate prompt for a specific agent by name, or use "list" to show available agents.`,
-		Args:  cobra.ExactArgs(1),
+		Use:               "agents [name|list]",
+		Short:             "Generate prompt for a specific agent or list available agents",
+		Long:              `Generate prompt for a specific agent by name, or use "list" to show available agents.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplateNames(generator.ItemTypeAgent),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			gen, err := createGenerator()
+			gen, err := createGenerator(cmd.ErrOrStderr())
 			if err != nil {
 				return fmt.Errorf("failed to create generator: %w", err)
 			}
 
 			if args[0] == "list" {
-				agents := gen.List(generator.ItemTypeAgent)
-				for _, name := range agents {
-					fmt.Println(name)
-				}
+				printTemplateList(gen, generator.ItemTypeAgent)
 				return nil
 			}
 
-			if err := gen.Generate(generator.ItemTypeAgent, args[0]); err != nil {
+			if err := generateItem(gen, generator.ItemTypeAgent, args[0], outputDir, generator.WriteOptions{Force: force}); err != nil {
 				return fmt.Errorf("failed to generate agent: %w", err)
 			}
 
@@ -83,30 +153,33 @@ func newAgentsCmd() *cobra.Command {
 		},
 	}
 
+	addOutputFlags(cmd, &outputDir, &force)
+
 	return cmd
 }
 
 func newCommandsCmd() *cobra.Command {
+	var outputDir string
+	var force bool
+
 	cmd := &cobra.Command{
-		Use:   "commands [name|list]",
-		Short: "Generate prompt for a specific command or list available commands",
-		Long:  `Generate prompt for a specific command by name, or use "list" to show available commands.`,
-		Args:  cobra.ExactArgs(1),
+		Use:               "commands [name|list]",
+		Short:             "Generate prompt for a specific command or list available commands",
+		Long:              `Generate prompt for a specific command by name, or use "list" to show available commands.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplateNames(generator.ItemTypeCommand),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			gen, err := createGenerator()
+			gen, err := createGenerator(cmd.ErrOrStderr())
 			if err != nil {
 				return fmt.Errorf("failed to create generator: %w", err)
 			}
 
 			if args[0] == "list" {
-				commands := gen.List(generator.ItemTypeCommand)
-				for _, name := range commands {
-					fmt.Println(name)
-				}
+				printTemplateList(gen, generator.ItemTypeCommand)
 				return nil
 			}
 
-			if err := gen.Generate(generator.ItemTypeCommand, args[0]); err != nil {
+			if err := generateItem(gen, generator.ItemTypeCommand, args[0], outputDir, generator.WriteOptions{Force: force}); err != nil {
 				return fmt.Errorf("failed to generate command: %w", err)
 			}
 
@@ -114,30 +187,33 @@ func newCommandsCmd() *cobra.Command {
 		},
 	}
 
+	addOutputFlags(cmd, &outputDir, &force)
+
 	return cmd
 }
 
 func newSkillsCmd() *cobra.Command {
+	var outputDir string
+	var force bool
+
 	cmd := &cobra.Command{
-		Use:   "skills [name|list]",
-		Short: "Generate prompt for a specific skill or list available skills",
-		Long:  `Generate prompt for a specific skill by name, or use "list" to show available skills.`,
-		Args:  cobra.ExactArgs(1),
+		Use:               "skills [name|list]",
+		Short:             "Generate prompt for a specific skill or list available skills",
+		Long:              `Generate prompt for a specific skill by name, or use "list" to show available skills.`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplateNames(generator.ItemTypeSkill),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			gen, err := createGenerator()
+			gen, err := createGenerator(cmd.ErrOrStderr())
 			if err != nil {
 				return fmt.Errorf("failed to create generator: %w", err)
 			}
 
 			if args[0] == "list" {
-				skills := gen.List(generator.ItemTypeSkill)
-				for _, name := range skills {
-					fmt.Println(name)
-				}
+				printTemplateList(gen, generator.ItemTypeSkill)
 				return nil
 			}
 
-			if err := gen.Generate(generator.ItemTypeSkill, args[0]); err != nil {
+			if err := generateItem(gen, generator.ItemTypeSkill, args[0], outputDir, generator.WriteOptions{Force: force}); err != nil {
 				return fmt.Errorf("failed to generate skill: %w", err)
 			}
 
@@ -145,5 +221,7 @@ func newSkillsCmd() *cobra.Command {
 		},
 	}
 
+	addOutputFlags(cmd, &outputDir, &force)
+
 	return cmd
 }
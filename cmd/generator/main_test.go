@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"io"
 	"os"
 	"path/filepath"
 	"testing"
@@ -22,6 +23,14 @@ func restoreTemplateDir(saved string) {
 	templateDir = saved
 }
 
+// useEmptyTemplateDirs runs the test in an empty project directory with an empty home directory,
+// so that only the embedded templates are loaded without --template-dir.
+func useEmptyTemplateDirs(t *testing.T) {
+	t.Helper()
+	t.Chdir(t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+}
+
 func TestNewRootCmd(t *testing.T) {
 	cmd := newRootCmd()
 
@@ -97,6 +106,8 @@ func TestNewSkillsCmd(t *testing.T) {
 }
 
 func TestCreateGenerator(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		setupFunc   func(t *testing.T) string
@@ -201,7 +212,7 @@ func TestCreateGenerator(t *testing.T) {
 			saved := tt.setupFunc(t)
 			defer tt.cleanupFunc(t, saved)
 
-			gen, err := createGenerator()
+			gen, err := createGenerator(io.Discard)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -216,6 +227,8 @@ func TestCreateGenerator(t *testing.T) {
 }
 
 func TestAgentsCmd_Execute(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -291,6 +304,8 @@ func TestAgentsCmd_Execute(t *testing.T) {
 }
 
 func TestCommandsCmd_Execute(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -366,6 +381,8 @@ func TestCommandsCmd_Execute(t *testing.T) {
 }
 
 func TestSkillsCmd_Execute(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -440,7 +457,166 @@ func TestSkillsCmd_Execute(t *testing.T) {
 	}
 }
 
+func TestItemCmds_OutputDir(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
+	tests := []struct {
+		name        string
+		newCmd      func() *cobra.Command
+		itemName    string
+		extraArgs   []string
+		existing    bool
+		force       bool
+		wantPath    string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "agents writes agent file",
+			newCmd:   newAgentsCmd,
+			itemName: "code-reviewer",
+			wantPath: "code-reviewer.md",
+		},
+		{
+			name:     "commands writes command file",
+			newCmd:   newCommandsCmd,
+			itemName: "feature",
+			wantPath: "feature.md",
+		},
+		{
+			name:     "skills writes skill file",
+			newCmd:   newSkillsCmd,
+			itemName: "coding",
+			wantPath: "coding.md",
+		},
+		{
+			name:     "rules writes rule file",
+			newCmd:   newRulesCmd,
+			itemName: "golang",
+			wantPath: "golang.md",
+		},
+		{
+			name:      "rules writes rule file with custom filename",
+			newCmd:    newRulesCmd,
+			itemName:  "golang",
+			extraArgs: []string{"--filename", "custom.md"},
+			wantPath:  "custom.md",
+		},
+		{
+			name:        "existing file without force returns error",
+			newCmd:      newAgentsCmd,
+			itemName:    "code-reviewer",
+			existing:    true,
+			wantPath:    "code-reviewer.md",
+			wantErr:     true,
+			errContains: "already exists (use --force to overwrite)",
+		},
+		{
+			name:        "existing rule file without force returns error",
+			newCmd:      newRulesCmd,
+			itemName:    "golang",
+			existing:    true,
+			wantPath:    "golang.md",
+			wantErr:     true,
+			errContains: "already exists (use --force to overwrite)",
+		},
+		{
+			name:     "existing file with force is overwritten",
+			newCmd:   newAgentsCmd,
+			itemName: "code-reviewer",
+			existing: true,
+			force:    true,
+			wantPath: "code-reviewer.md",
+		},
+		{
+			name:     "existing rule file with force is overwritten",
+			newCmd:   newRulesCmd,
+			itemName: "golang",
+			existing: true,
+			force:    true,
+			wantPath: "golang.md",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := saveTemplateDir()
+			templateDir = ""
+			defer restoreTemplateDir(saved)
+
+			outputDir := filepath.Join(t.TempDir(), "prompts")
+			outputPath := filepath.Join(outputDir, tt.wantPath)
+			if tt.existing {
+				require.NoError(t, os.MkdirAll(filepath.Dir(outputPath), 0755))
+				require.NoError(t, os.WriteFile(outputPath, []byte("existing"), 0644))
+			}
+
+			args := append([]string{tt.itemName, "--output-dir", outputDir}, tt.extraArgs...)
+			if tt.force {
+				args = append(args, "--force")
+			}
+
+			cmd := tt.newCmd()
+			buf := new(bytes.Buffer)
+			cmd.SetOut(buf)
+			cmd.SetErr(buf)
+			cmd.SetArgs(args)
+
+			err := cmd.Execute()
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			content, err := os.ReadFile(outputPath)
+			require.NoError(t, err)
+			assert.NotEqual(t, "existing", string(content))
+			assert.NotEmpty(t, content)
+		})
+	}
+}
+
+func TestCreateGenerator_UserTemplateDirs(t *testing.T) {
+	saved := saveTemplateDir()
+	templateDir = ""
+	defer restoreTemplateDir(saved)
+
+	projectDir := t.TempDir()
+	homeDir := t.TempDir()
+	t.Chdir(projectDir)
+	t.Setenv("HOME", homeDir)
+
+	files := map[string]string{
+		filepath.Join(projectDir, ".claude", "templates", "prompts", "agents", "code-reviewer.tmpl"):                   "Project {{.Name}}",
+		filepath.Join(homeDir, ".config", "claude-code-tools", "templates", "prompts", "agents", "code-reviewer.tmpl"): "User {{.Name}}",
+		filepath.Join(homeDir, ".config", "claude-code-tools", "templates", "prompts", "agents", "team-agent.tmpl"):    "User {{.Name}}",
+		filepath.Join(projectDir, ".claude", "templates", "prompts", "agents", "broken.tmpl"):                          "Broken {{.Name",
+	}
+	for path, content := range files {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+
+	warnings := new(bytes.Buffer)
+	gen, err := createGenerator(warnings)
+	require.NoError(t, err)
+
+	assert.Equal(t, generator.SourceProject, gen.Source(generator.ItemTypeAgent, "code-reviewer"))
+	assert.Equal(t, generator.SourceUser, gen.Source(generator.ItemTypeAgent, "team-agent"))
+	assert.Equal(t, generator.SourceEmbedded, gen.Source(generator.ItemTypeAgent, "software-architect"))
+	assert.Equal(t, generator.SourceEmbedded, gen.Source(generator.ItemTypeCommand, "feature"))
+	assert.NotContains(t, gen.List(generator.ItemTypeAgent), "broken")
+	require.Len(t, gen.Warnings(), 1)
+	assert.Contains(t, gen.Warnings()[0], "skipped prompts/agents/broken.tmpl from project templates")
+	assert.Equal(t, "Warning: "+gen.Warnings()[0]+"\n", warnings.String())
+}
+
 func TestCreateGenerator_InvalidTemplateDir(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		setupFunc   func(t *testing.T) string
@@ -480,7 +656,7 @@ func TestCreateGenerator_InvalidTemplateDir(t *testing.T) {
 			saved := tt.setupFunc(t)
 			defer tt.cleanupFunc(t, saved)
 
-			gen, err := createGenerator()
+			gen, err := createGenerator(io.Discard)
 
 			if tt.wantErr {
 				require.Error(t, err)
@@ -697,6 +873,8 @@ func TestSkillsCmd_List(t *testing.T) {
 }
 
 func TestAgentsCmd_CreateGeneratorError(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	saved := saveTemplateDir()
 	defer restoreTemplateDir(saved)
 
@@ -714,6 +892,8 @@ func TestAgentsCmd_CreateGeneratorError(t *testing.T) {
 }
 
 func TestCommandsCmd_CreateGeneratorError(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	saved := saveTemplateDir()
 	defer restoreTemplateDir(saved)
 
@@ -731,6 +911,8 @@ func TestCommandsCmd_CreateGeneratorError(t *testing.T) {
 }
 
 func TestSkillsCmd_CreateGeneratorError(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	saved := saveTemplateDir()
 	defer restoreTemplateDir(saved)
 
@@ -766,6 +948,8 @@ func TestNewRulesCmd(t *testing.T) {
 }
 
 func TestRulesCmd_Execute(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -854,6 +1038,8 @@ func TestRulesCmd_List(t *testing.T) {
 }
 
 func TestRulesCmd_CreateGeneratorError(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	saved := saveTemplateDir()
 	defer restoreTemplateDir(saved)
 
@@ -871,6 +1057,8 @@ func TestRulesCmd_CreateGeneratorError(t *testing.T) {
 }
 
 func TestRulesCmd_WithFlags(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -974,6 +1162,8 @@ func TestRulesCmd_WithFlags(t *testing.T) {
 }
 
 func TestRulesInitCmd(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	tests := []struct {
 		name        string
 		args        []string
@@ -1066,6 +1256,8 @@ func TestRulesInitCmd(t *testing.T) {
 }
 
 func TestRulesInitCmd_CreateGeneratorError(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
 	saved := saveTemplateDir()
 	defer restoreTemplateDir(saved)
 
@@ -1184,3 +1376,92 @@ func TestEnhanceRuleError(t *testing.T) {
 		})
 	}
 }
+
+func TestCompleteTemplateNames(t *testing.T) {
+	useEmptyTemplateDirs(t)
+
+	tests := []struct {
+		name          string
+		templateDir   string
+		itemType      generator.ItemType
+		args          []string
+		wantContains  []string
+		wantEmpty     bool
+		wantDirective cobra.ShellCompDirective
+	}{
+		{
+			name:          "completes list and agent names",
+			itemType:      generator.ItemTypeAgent,
+			args:          []string{},
+			wantContains:  []string{"list", "software-engineer"},
+			wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name:          "completes list and rule names",
+			itemType:      generator.ItemTypeRule,
+			args:          []string{},
+			wantContains:  []string{"list", "golang"},
+			wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name:          "no completion after the first argument",
+			itemType:      generator.ItemTypeCommand,
+			args:          []string{"feature"},
+			wantEmpty:     true,
+			wantDirective: cobra.ShellCompDirectiveNoFileComp,
+		},
+		{
+			name:          "invalid template directory returns error directive",
+			templateDir:   "/non/existent/path",
+			itemType:      generator.ItemTypeSkill,
+			args:          []string{},
+			wantEmpty:     true,
+			wantDirective: cobra.ShellCompDirectiveError,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			saved := saveTemplateDir()
+			defer restoreTemplateDir(saved)
+			templateDir = tt.templateDir
+
+			completeFunc := completeTemplateNames(tt.itemType)
+			got, gotDirective := completeFunc(&cobra.Command{}, tt.args, "")
+
+			assert.Equal(t, tt.wantDirective, gotDirective)
+			if tt.wantEmpty {
+				assert.Empty(t, got)
+				return
+			}
+			for _, want := range tt.wantContains {
+				assert.Contains(t, got, want)
+			}
+		})
+	}
+}
+
+func TestCompleteTemplateNames_DoesNotPrintWarnings(t *testing.T) {
+	saved := saveTemplateDir()
+	templateDir = ""
+	defer restoreTemplateDir(saved)
+
+	projectDir := t.TempDir()
+	t.Chdir(projectDir)
+	t.Setenv("HOME", t.TempDir())
+
+	brokenPath := filepath.Join(projectDir, ".claude", "templates", "prompts", "agents", "broken.tmpl")
+	require.NoError(t, os.MkdirAll(filepath.Dir(brokenPath), 0755))
+	require.NoError(t, os.WriteFile(brokenPath, []byte("Broken {{.Name"), 0644))
+
+	cmd := &cobra.Command{}
+	errBuf := new(bytes.Buffer)
+	cmd.SetErr(errBuf)
+
+	got, gotDirective := completeTemplateNames(generator.ItemTypeAgent)(cmd, []string{}, "")
+
+	assert.Equal(t, cobra.ShellCompDirectiveNoFileComp, gotDirective)
+	assert.Contains(t, got, "list")
+	assert.NotContains(t, got, "broken")
+	assert.Empty(t, errBuf.String())
+}
@@ -2,8 +2,6 @@ package main
 
 import (
 	"fmt"
-	"os"
-	"path/filepath"
 	"strings"
 
 	"github.com/michael-freling/claude-code-tools/internal/generator"
@@ -27,6 +25,7 @@ func newRulesCmd() *cobra.Command {
 	var paths []string
 	var outputDir string
 	var filename string
+	var force bool
 
 	cmd := &cobra.Command{
 		Use:   "rules [name|list]",
@@ -41,14 +40,18 @@ func newRulesCmd() *cobra.Command {
   # Generate with custom paths
   generator rules golang --paths "src/**/*.go" --paths "pkg/**/*.go"
 
-  # Generate to file with default name (golang.md)
+  # Generate to file with the name from the rule metadata (golang.md)
   generator rules golang --output-dir .claude/rules/
 
   # Generate to file with custom name
-  generator rules golang --output-dir .claude/rules/ --filename custom-golang.md`,
-		Args: cobra.ExactArgs(1),
+  generator rules golang --output-dir .claude/rules/ --filename custom-golang.md
+
+  # Overwrite an existing file
+  generator rules golang --output-dir .claude/rules/ --force`,
+		Args:              cobra.ExactArgs(1),
+		ValidArgsFunction: completeTemplateNames(generator.ItemTypeRule),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			gen, err := createGenerator()
+			gen, err := createGenerator(cmd.ErrOrStderr())
 			if err != nil {
 				return fmt.Errorf("failed to create generator: %w", err)
 			}
@@ -56,10 +59,7 @@ func newRulesCmd() *cobra.Command {
 			ruleName := args[0]
 
 			if ruleName == "list" {
-				rules := gen.List(generator.ItemTypeRule)
-				for _, name := range rules {
-					fmt.Println(name)
-				}
+				printTemplateList(gen, generator.ItemTypeRule)
 				return nil
 			}
 
@@ -67,40 +67,36 @@ func newRulesCmd() *cobra.Command {
 				return fmt.Errorf("invalid rule name %q: rule names cannot contain path separators (/, \\) or parent directory traversal (..)", ruleName)
 			}
 
-			content, err := gen.GenerateRuleWithOptions(ruleName, generator.GenerateOptions{
-				Paths: paths,
-			})
-			if err != nil {
-				return enhanceRuleError(err, ruleName, gen)
+			opts := generator.WriteOptions{
+				GenerateOptions: generator.GenerateOptions{
+					Paths: paths,
+				},
+				Filename: filename,
+				Force:    force,
 			}
-
-			if outputDir != "" {
-				if err := os.MkdirAll(outputDir, 0755); err != nil {
-					return fmt.Errorf("failed to create directory %s: %w", outputDir, err)
-				}
-
-				outputFilename := filename
-				if outputFilename == "" {
-					outputFilename = fmt.Sprintf("%s.md", ruleName)
-				}
-
-				outputPath := filepath.Join(outputDir, outputFilename)
-				if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
-					return fmt.Errorf("failed to write file %s: %w", outputPath, err)
+			if outputDir == "" {
+				content, err := gen.GenerateRuleWithOptions(ruleName, opts.GenerateOptions)
+				if err != nil {
+					return enhanceRuleError(err, ruleName, gen)
 				}
 
-				fmt.Printf("Created %s\n", outputPath)
+				fmt.Println(content)
 				return nil
 			}
 
-			fmt.Println(content)
+			outputPath, err := gen.GenerateToDir(generator.ItemTypeRule, ruleName, outputDir, opts)
+			if err != nil {
+				return enhanceRuleError(err, ruleName, gen)
+			}
+
+			fmt.Printf("Created %s\n", outputPath)
 			return nil
 		},
 	}
 
 	cmd.Flags().StringSliceVar(&paths, "paths", []string{}, "Override default paths from metadata")
-	cmd.Flags().StringVar(&outputDir, "output-dir", "", "Write output to file in specified directory")
-	cmd.Flags().StringVar(&filename, "filename", "", "Custom output filename (default: {template-name}.md)")
+	addOutputFlags(cmd, &outputDir, &force)
+	cmd.Flags().StringVar(&filename, "filename", "", "Custom output filename (default: filename from the rule metadata, or {template-name}.md)")
 
 	cmd.AddCommand(newRulesInitCmd())
 
@@ -128,7 +124,7 @@ func newRulesInitCmd() *cobra.Command {
   # Overwrite existing files
   generator rules init --force`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			gen, err := createGenerator()
+			gen, err := createGenerator(cmd.ErrOrStderr())
 			if err != nil {
 				return fmt.Errorf("failed to create generator: %w", err)
 			}
@@ -7,24 +7,47 @@ import (
 	"strings"
 )
 
+// PRCreateOptions holds options for creating a PR
+type PRCreateOptions struct {
+	Title string
+	Body  string
+	Head  string
+	Base  string // Base branch (default: repository default branch)
+	Draft bool   // Open the PR as a draft
+}
+
 // GhRunner abstracts gh CLI command execution for testing
 type GhRunner interface {
 	// PRCreate creates a new PR and returns the PR URL
 	PRCreate(ctx context.Context, dir string, title, body, head, base string) (prURL string, err error)
+	// PRCreateWithOptions creates a new PR with the given options and returns the PR URL
+	PRCreateWithOptions(ctx context.Context, dir string, opts PRCreateOptions) (prURL string, err error)
+	// PRReady marks a draft PR as ready for review
+	PRReady(ctx context.Context, dir string, prNumber int) error
 	// PREdit updates the body of an existing PR
 	PREdit(ctx context.Context, dir string, prNumber int, body string) error
+	// PREditTitle updates the title of an existing PR
+	PREditTitle(ctx context.Context, dir string, prNumber int, title string) error
+	// PRAddReviewers requests reviews from users or teams on a PR
+	PRAddReviewers(ctx context.Context, dir string, prNumber int, reviewers []string) error
+	// PRAddAssignees assigns users to a PR
+	PRAddAssignees(ctx context.Context, dir string, prNumber int, assignees []string) error
 	// PRClose closes a PR
 	PRClose(ctx context.Context, dir string, prNumber int) error
 	// PRView returns PR info as JSON
 	PRView(ctx context.Context, dir string, jsonFields string, jqQuery string) (output string, err error)
 	// PRChecks returns CI check status as JSON
 	PRChecks(ctx context.Context, dir string, prNumber int, jsonFields string) (output string, err error)
+	// FindOpenPR returns the number of the open PR whose head is the given branch, or 0 if there is none
+	FindOpenPR(ctx context.Context, dir string, head string) (int, error)
 	// GetPRBaseBranch returns the base branch name for a pull request
 	GetPRBaseBranch(ctx context.Context, dir string, prNumber string) (string, error)
 	// RunRerun reruns failed/cancelled jobs for a workflow run
 	RunRerun(ctx context.Context, dir string, runID int64) error
 	// GetLatestRunID gets the latest workflow run ID for a PR
 	GetLatestRunID(ctx context.Context, dir string, prNumber int) (int64, error)
+	// RunViewLogFailed returns the logs of failed jobs for a workflow run
+	RunViewLogFailed(ctx context.Context, dir string, runID int64) (string, error)
 }
 
 // ghRunner implements GhRunner interface
@@ -41,16 +64,29 @@ func NewGhRunner(runner Runner) GhRunner {
 
 // PRCreate creates a new PR and returns the PR URL
 func (g *ghRunner) PRCreate(ctx context.Context, dir string, title, body, head, base string) (string, error) {
-	if title == "" {
+	return g.PRCreateWithOptions(ctx, dir, PRCreateOptions{
+		Title: title,
+		Body:  body,
+		Head:  head,
+		Base:  base,
+	})
+}
+
+// PRCreateWithOptions creates a new PR with the given options and returns the PR URL
+func (g *ghRunner) PRCreateWithOptions(ctx context.Context, dir string, opts PRCreateOptions) (string, error) {
+	if opts.Title == "" {
 		return "", fmt.Errorf("title cannot be empty")
 	}
-	if head == "" {
+	if opts.Head == "" {
 		return "", fmt.Errorf("head branch cannot be empty")
 	}
 
-	args := []string{"pr", "create", "--title", title, "--body", body, "--head", head}
-	if base != "" {
-		args = append(args, "--base", base)
+	args := []string{"pr", "create", "--title", opts.Title, "--body", opts.Body, "--head", opts.Head}
+	if opts.Base != "" {
+		args = append(args, "--base", opts.Base)
+	}
+	if opts.Draft {
+		args = append(args, "--draft")
 	}
 
 	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
@@ -61,6 +97,22 @@ func (g *ghRunner) PRCreate(ctx context.Context, dir string, title, body, head,
 	return strings.TrimSpace(stdout), nil
 }
 
+// PRReady marks a draft PR as ready for review
+func (g *ghRunner) PRReady(ctx context.Context, dir string, prNumber int) error {
+	if prNumber <= 0 {
+		return fmt.Errorf("PR number must be positive, got %d", prNumber)
+	}
+
+	args := []string{"pr", "ready", fmt.Sprintf("%d", prNumber)}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
+	if err != nil {
+		return fmt.Errorf("failed to mark PR %d as ready for review: %w (stderr: %s)", prNumber, err, stderr)
+	}
+
+	return nil
+}
+
 // PREdit updates the body of an existing PR
 func (g *ghRunner) PREdit(ctx context.Context, dir string, prNumber int, body string) error {
 	if prNumber <= 0 {
@@ -77,6 +129,61 @@ func (g *ghRunner) PREdit(ctx context.Context, dir string, prNumber int, body st
 	return nil
 }
 
+// PREditTitle updates the title of an existing PR, e.g. to fix a title that violates team conventions
+func (g *ghRunner) PREditTitle(ctx context.Context, dir string, prNumber int, title string) error {
+	if prNumber <= 0 {
+		return fmt.Errorf("PR number must be positive, got %d", prNumber)
+	}
+	if title == "" {
+		return fmt.Errorf("PR title cannot be empty")
+	}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "gh", "pr", "edit", fmt.Sprintf("%d", prNumber), "--title", title)
+	if err != nil {
+		return fmt.Errorf("failed to edit title of PR %d: %w (stderr: %s)", prNumber, err, stderr)
+	}
+
+	return nil
+}
+
+// PRAddReviewers requests reviews from users or teams (e.g. "org/team") on a PR
+func (g *ghRunner) PRAddReviewers(ctx context.Context, dir string, prNumber int, reviewers []string) error {
+	if prNumber <= 0 {
+		return fmt.Errorf("PR number must be positive, got %d", prNumber)
+	}
+	if len(reviewers) == 0 {
+		return fmt.Errorf("reviewers list cannot be empty")
+	}
+
+	args := []string{"pr", "edit", fmt.Sprintf("%d", prNumber), "--add-reviewer", strings.Join(reviewers, ",")}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
+	if err != nil {
+		return fmt.Errorf("failed to add reviewers to PR %d: %w (stderr: %s)", prNumber, err, stderr)
+	}
+
+	return nil
+}
+
+// PRAddAssignees assigns users to a PR
+func (g *ghRunner) PRAddAssignees(ctx context.Context, dir string, prNumber int, assignees []string) error {
+	if prNumber <= 0 {
+		return fmt.Errorf("PR number must be positive, got %d", prNumber)
+	}
+	if len(assignees) == 0 {
+		return fmt.Errorf("assignees list cannot be empty")
+	}
+
+	args := []string{"pr", "edit", fmt.Sprintf("%d", prNumber), "--add-assignee", strings.Join(assignees, ",")}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
+	if err != nil {
+		return fmt.Errorf("failed to add assignees to PR %d: %w (stderr: %s)", prNumber, err, stderr)
+	}
+
+	return nil
+}
+
 // PRClose closes a PR
 func (g *ghRunner) PRClose(ctx context.Context, dir string, prNumber int) error {
 	if prNumber <= 0 {
@@ -146,6 +253,34 @@ func (g *ghRunner) RunRerun(ctx context.Context, dir string, runID int64) error
 	return nil
 }
 
+// FindOpenPR returns the number of the open PR whose head is the given branch, or 0 if there is none
+func (g *ghRunner) FindOpenPR(ctx context.Context, dir string, head string) (int, error) {
+	if head == "" {
+		return 0, fmt.Errorf("head branch cannot be empty")
+	}
+
+	args := []string{"pr", "list", "--head", head, "--state", "open", "--json", "number"}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to list PRs for branch %s: %w (stderr: %s)", head, err, stderr)
+	}
+
+	// Parse JSON array output: [{"number": 123}, ...]
+	var prs []struct {
+		Number int `json:"number"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &prs); err != nil {
+		return 0, fmt.Errorf("failed to parse PR list from output: %w", err)
+	}
+
+	if len(prs) == 0 {
+		return 0, nil
+	}
+
+	return prs[0].Number, nil
+}
+
 // GetLatestRunID gets the latest workflow run ID for a PR
 func (g *ghRunner) GetLatestRunID(ctx context.Context, dir string, prNumber int) (int64, error) {
 	args := []string{"pr", "checks", fmt.Sprintf("%d", prNumber), "--json", "databaseId"}
@@ -170,3 +305,19 @@ func (g *ghRunner) GetLatestRunID(ctx context.Context, dir string, prNumber int)
 	// Return the first (latest) run ID
 	return checks[0].DatabaseID, nil
 }
+
+// RunViewLogFailed returns the logs of failed jobs for a workflow run
+func (g *ghRunner) RunViewLogFailed(ctx context.Context, dir string, runID int64) (string, error) {
+	if runID <= 0 {
+		return "", fmt.Errorf("run ID must be positive, got %d", runID)
+	}
+
+	args := []string{"run", "view", fmt.Sprintf("%d", runID), "--log-failed"}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "gh", args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get failed logs for run %d: %w (stderr: %s)", runID, err, stderr)
+	}
+
+	return stdout, nil
+}
@@ -127,6 +127,176 @@ func TestGhRunner_PRCreate(t *testing.T) {
 	}
 }
 
+func TestGhRunner_PRCreateWithOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		opts        PRCreateOptions
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "creates draft PR successfully",
+			dir:  "/test/repo",
+			opts: PRCreateOptions{
+				Title: "Test PR",
+				Body:  "Test body",
+				Head:  "feature-branch",
+				Base:  "main",
+				Draft: true,
+			},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "create", "--title", "Test PR", "--body", "Test body", "--head", "feature-branch", "--base", "main", "--draft").
+					Return("https://github.com/owner/repo/pull/123\n", "", nil)
+			},
+			want:    "https://github.com/owner/repo/pull/123",
+			wantErr: false,
+		},
+		{
+			name: "creates non-draft PR without base branch",
+			dir:  "/test/repo",
+			opts: PRCreateOptions{
+				Title: "Test PR",
+				Body:  "Test body",
+				Head:  "feature-branch",
+			},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "create", "--title", "Test PR", "--body", "Test body", "--head", "feature-branch").
+					Return("https://github.com/owner/repo/pull/123\n", "", nil)
+			},
+			want:    "https://github.com/owner/repo/pull/123",
+			wantErr: false,
+		},
+		{
+			name: "fails when gh command fails",
+			dir:  "/test/repo",
+			opts: PRCreateOptions{
+				Title: "Test PR",
+				Body:  "Test body",
+				Head:  "feature-branch",
+				Draft: true,
+			},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "create", "--title", "Test PR", "--body", "Test body", "--head", "feature-branch", "--draft").
+					Return("", "error: failed to create PR", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to create PR",
+		},
+		{
+			name:        "fails when title is empty",
+			dir:         "/test/repo",
+			opts:        PRCreateOptions{Head: "feature-branch", Draft: true},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "title cannot be empty",
+		},
+		{
+			name:        "fails when head is empty",
+			dir:         "/test/repo",
+			opts:        PRCreateOptions{Title: "Test PR", Draft: true},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "head branch cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			ctx := context.Background()
+
+			got, err := ghRunner.PRCreateWithOptions(ctx, tt.dir, tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGhRunner_PRReady(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		prNumber    int
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "marks PR ready successfully",
+			dir:      "/test/repo",
+			prNumber: 123,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "ready", "123").
+					Return("", "", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:     "fails when gh command fails",
+			dir:      "/test/repo",
+			prNumber: 123,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "ready", "123").
+					Return("", "error: pull request not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to mark PR 123 as ready for review",
+		},
+		{
+			name:        "fails when PR number is zero",
+			dir:         "/test/repo",
+			prNumber:    0,
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "PR number must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			ctx := context.Background()
+
+			err := ghRunner.PRReady(ctx, tt.dir, tt.prNumber)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGhRunner_PRView(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -575,6 +745,235 @@ func TestGhRunner_PREdit(t *testing.T) {
 	}
 }
 
+func TestGhRunner_PREditTitle(t *testing.T) {
+	tests := []struct {
+		name        string
+		prNumber    int
+		title       string
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:     "edits PR title successfully",
+			prNumber: 123,
+			title:    "feat: add PR title linting",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--title", "feat: add PR title linting").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:        "fails when PR number is zero",
+			prNumber:    0,
+			title:       "feat: add PR title linting",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "PR number must be positive",
+		},
+		{
+			name:        "fails when title is empty",
+			prNumber:    123,
+			title:       "",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "PR title cannot be empty",
+		},
+		{
+			name:     "fails when gh command fails",
+			prNumber: 123,
+			title:    "feat: add PR title linting",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--title", "feat: add PR title linting").
+					Return("", "error: pull request not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to edit title of PR 123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			err := ghRunner.PREditTitle(context.Background(), "/test/repo", tt.prNumber, tt.title)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGhRunner_PRAddReviewers(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		prNumber    int
+		reviewers   []string
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "adds reviewers successfully",
+			dir:       "/test/repo",
+			prNumber:  123,
+			reviewers: []string{"alice", "org/backend-team"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--add-reviewer", "alice,org/backend-team").
+					Return("https://github.com/owner/repo/pull/123", "", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "fails when gh command fails",
+			dir:       "/test/repo",
+			prNumber:  123,
+			reviewers: []string{"alice", "org/backend-team"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--add-reviewer", "alice,org/backend-team").
+					Return("", "could not request reviewer: 'org/backend-team' not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to add reviewers to PR 123",
+		},
+		{
+			name:        "fails when PR number is zero",
+			dir:         "/test/repo",
+			prNumber:    0,
+			reviewers:   []string{"alice", "org/backend-team"},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "PR number must be positive",
+		},
+		{
+			name:        "fails when reviewers list is empty",
+			dir:         "/test/repo",
+			prNumber:    123,
+			reviewers:   []string{},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "reviewers list cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			ctx := context.Background()
+
+			err := ghRunner.PRAddReviewers(ctx, tt.dir, tt.prNumber, tt.reviewers)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGhRunner_PRAddAssignees(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		prNumber    int
+		assignees   []string
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:      "adds assignees successfully",
+			dir:       "/test/repo",
+			prNumber:  123,
+			assignees: []string{"alice", "bob"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--add-assignee", "alice,bob").
+					Return("https://github.com/owner/repo/pull/123", "", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:      "fails when gh command fails",
+			dir:       "/test/repo",
+			prNumber:  123,
+			assignees: []string{"alice", "bob"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "edit", "123", "--add-assignee", "alice,bob").
+					Return("", "'bob' not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to add assignees to PR 123",
+		},
+		{
+			name:        "fails when PR number is zero",
+			dir:         "/test/repo",
+			prNumber:    0,
+			assignees:   []string{"alice", "bob"},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "PR number must be positive",
+		},
+		{
+			name:        "fails when assignees list is empty",
+			dir:         "/test/repo",
+			prNumber:    123,
+			assignees:   []string{},
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "assignees list cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			ctx := context.Background()
+
+			err := ghRunner.PRAddAssignees(ctx, tt.dir, tt.prNumber, tt.assignees)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGhRunner_PRClose(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -648,3 +1047,155 @@ func TestGhRunner_PRClose(t *testing.T) {
 		})
 	}
 }
+
+func TestGhRunner_RunViewLogFailed(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		runID       int64
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "gets failed logs successfully",
+			dir:   "/test/repo",
+			runID: 123456,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "run", "view", "123456", "--log-failed").
+					Return("test\tRun go test\t--- FAIL: TestFoo", "", nil)
+			},
+			want:    "test\tRun go test\t--- FAIL: TestFoo",
+			wantErr: false,
+		},
+		{
+			name:  "fails when gh command fails",
+			dir:   "/test/repo",
+			runID: 123456,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "run", "view", "123456", "--log-failed").
+					Return("", "run 123456 not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to get failed logs for run 123456",
+		},
+		{
+			name:        "fails when run ID is zero",
+			dir:         "/test/repo",
+			runID:       0,
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "run ID must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			ctx := context.Background()
+
+			got, err := ghRunner.RunViewLogFailed(ctx, tt.dir, tt.runID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGhRunner_FindOpenPR(t *testing.T) {
+	tests := []struct {
+		name        string
+		head        string
+		setupMock   func(*MockRunner)
+		want        int
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "returns PR number when open PR exists",
+			head: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "list", "--head", "feature", "--state", "open", "--json", "number").
+					Return(`[{"number":42}]`, "", nil)
+			},
+			want: 42,
+		},
+		{
+			name: "returns 0 when no open PR exists",
+			head: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "list", "--head", "feature", "--state", "open", "--json", "number").
+					Return(`[]`, "", nil)
+			},
+			want: 0,
+		},
+		{
+			name:        "fails when head is empty",
+			head:        "",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "head branch cannot be empty",
+		},
+		{
+			name: "fails when gh pr list fails",
+			head: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "list", "--head", "feature", "--state", "open", "--json", "number").
+					Return("", "HTTP 401: Bad credentials", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to list PRs for branch feature",
+		},
+		{
+			name: "fails when output is invalid JSON",
+			head: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "gh", "pr", "list", "--head", "feature", "--state", "open", "--json", "number").
+					Return("not json", "", nil)
+			},
+			wantErr:     true,
+			errContains: "failed to parse PR list from output",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			ghRunner := NewGhRunner(mockRunner)
+			got, err := ghRunner.FindOpenPR(context.Background(), "/test/repo", tt.head)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -2,8 +2,11 @@ package command
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
 	"strings"
+	"time"
 )
 
 // Commit represents a git commit with its hash and subject
@@ -12,12 +15,34 @@ type Commit struct {
 	Subject string `json:"subject"`
 }
 
+// CloneOptions holds options for cloning a repository
+type CloneOptions struct {
+	Branch string // Branch to check out (default: remote HEAD)
+	Depth  int    // Number of commits to fetch for a shallow clone (default: full history)
+	Filter string // Partial clone filter, e.g. "blob:none" (default: no filter)
+}
+
+// CommitOptions holds options for creating a commit
+type CommitOptions struct {
+	Trailers map[string]string // Trailers appended to the message, e.g. "Workflow-Phase": "implement", in key order
+}
+
+// PushOptions holds options for pushing a branch
+type PushOptions struct {
+	ForceWithLease   bool          // Overwrite the remote branch only if it is still at the expected commit
+	ExpectedRevision string        // Commit the remote branch must be at for ForceWithLease (default: its remote-tracking branch, which a fetch can update)
+	Revision         string        // Commit to push to the branch instead of its tip, e.g. to push a large history in chunks (default: branch tip)
+	Timeout          time.Duration // Timeout of the push, separate from the caller's context deadline (default: no timeout)
+}
+
 // GitRunner abstracts git command execution
 type GitRunner interface {
 	// GetCurrentBranch returns the current git branch name
 	GetCurrentBranch(ctx context.Context, dir string) (string, error)
 	// Push pushes a branch to origin with upstream tracking
 	Push(ctx context.Context, dir string, branch string) error
+	// PushWithOptions pushes a branch to origin with upstream tracking and the given options
+	PushWithOptions(ctx context.Context, dir string, branch string, opts PushOptions) error
 	// WorktreeAdd creates a new git worktree
 	WorktreeAdd(ctx context.Context, dir string, path string, branch string) error
 	// WorktreeRemove removes a git worktree
@@ -36,12 +61,22 @@ type GitRunner interface {
 	DeleteRemoteBranch(ctx context.Context, dir string, branchName string) error
 	// CommitEmpty creates an empty commit
 	CommitEmpty(ctx context.Context, dir string, message string) error
+	// CommitEmptyWithOptions creates an empty commit with the given options
+	CommitEmptyWithOptions(ctx context.Context, dir string, message string, opts CommitOptions) error
 	// CheckoutFiles checks out specific files from a source branch
 	CheckoutFiles(ctx context.Context, dir string, sourceBranch string, files []string) error
 	// CommitAll stages all changes and creates a commit
 	CommitAll(ctx context.Context, dir string, message string) error
+	// CommitAllWithOptions stages all changes and creates a commit with the given options
+	CommitAllWithOptions(ctx context.Context, dir string, message string, opts CommitOptions) error
 	// GetDiffStat returns the diff stat output for the given base branch
 	GetDiffStat(ctx context.Context, dir string, base string) (string, error)
+	// Clone clones a repository into path
+	Clone(ctx context.Context, dir string, url string, path string, opts CloneOptions) error
+	// GetRemoteURL returns the URL of a remote
+	GetRemoteURL(ctx context.Context, dir string, remote string) (string, error)
+	// BranchExists checks if a branch exists locally or as a remote-tracking branch of origin
+	BranchExists(ctx context.Context, dir string, branchName string) (bool, error)
 }
 
 type gitRunner struct {
@@ -67,11 +102,46 @@ func (g *gitRunner) GetCurrentBranch(ctx context.Context, dir string) (string, e
 
 // Push pushes a branch to origin with upstream tracking
 func (g *gitRunner) Push(ctx context.Context, dir string, branch string) error {
+	return g.PushWithOptions(ctx, dir, branch, PushOptions{})
+}
+
+// PushWithOptions pushes a branch to origin with upstream tracking and the given options.
+// ForceWithLease without ExpectedRevision compares the remote branch with its remote-tracking branch,
+// so a fetch in the background can let the push overwrite commits the caller has not seen.
+// Set ExpectedRevision to the commit the branch was rewritten from to rule this out.
+func (g *gitRunner) PushWithOptions(ctx context.Context, dir string, branch string, opts PushOptions) error {
 	if branch == "" {
 		return fmt.Errorf("branch name cannot be empty")
 	}
+	if opts.Timeout < 0 {
+		return fmt.Errorf("push timeout cannot be negative, got %s", opts.Timeout)
+	}
 
-	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", "push", "-u", "origin", branch)
+	if opts.ExpectedRevision != "" && !opts.ForceWithLease {
+		return fmt.Errorf("expected revision requires force with lease")
+	}
+
+	args := []string{"push", "-u"}
+	if opts.ForceWithLease {
+		lease := "--force-with-lease"
+		if opts.ExpectedRevision != "" {
+			lease += "=refs/heads/" + branch + ":" + opts.ExpectedRevision
+		}
+		args = append(args, lease)
+	}
+	refspec := branch
+	if opts.Revision != "" {
+		refspec = opts.Revision + ":refs/heads/" + branch
+	}
+	args = append(args, "origin", refspec)
+
+	if opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, opts.Timeout)
+		defer cancel()
+	}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", args...)
 	if err != nil {
 		return fmt.Errorf("failed to push branch %s: %w (stderr: %s)", branch, err, stderr)
 	}
@@ -224,11 +294,21 @@ func (g *gitRunner) DeleteRemoteBranch(ctx context.Context, dir string, branchNa
 
 // CommitEmpty creates an empty commit
 func (g *gitRunner) CommitEmpty(ctx context.Context, dir string, message string) error {
+	return g.CommitEmptyWithOptions(ctx, dir, message, CommitOptions{})
+}
+
+// CommitEmptyWithOptions creates an empty commit with the given options
+func (g *gitRunner) CommitEmptyWithOptions(ctx context.Context, dir string, message string, opts CommitOptions) error {
 	if message == "" {
 		return fmt.Errorf("commit message cannot be empty")
 	}
+	trailers, err := trailerArgs(opts.Trailers)
+	if err != nil {
+		return err
+	}
 
-	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", "commit", "--allow-empty", "-m", message)
+	args := append([]string{"commit", "--allow-empty", "-m", message}, trailers...)
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", args...)
 	if err != nil {
 		return fmt.Errorf("failed to create empty commit: %w (stderr: %s)", err, stderr)
 	}
@@ -258,16 +338,26 @@ func (g *gitRunner) CheckoutFiles(ctx context.Context, dir string, sourceBranch
 
 // CommitAll stages all changes and creates a commit
 func (g *gitRunner) CommitAll(ctx context.Context, dir string, message string) error {
+	return g.CommitAllWithOptions(ctx, dir, message, CommitOptions{})
+}
+
+// CommitAllWithOptions stages all changes and creates a commit with the given options
+func (g *gitRunner) CommitAllWithOptions(ctx context.Context, dir string, message string, opts CommitOptions) error {
 	if message == "" {
 		return fmt.Errorf("commit message cannot be empty")
 	}
+	trailers, err := trailerArgs(opts.Trailers)
+	if err != nil {
+		return err
+	}
 
 	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", "add", "-A")
 	if err != nil {
 		return fmt.Errorf("failed to stage changes: %w (stderr: %s)", err, stderr)
 	}
 
-	_, stderr, err = g.runner.RunInDir(ctx, dir, "git", "commit", "-m", message)
+	args := append([]string{"commit", "-m", message}, trailers...)
+	_, stderr, err = g.runner.RunInDir(ctx, dir, "git", args...)
 	if err != nil {
 		return fmt.Errorf("failed to create commit: %w (stderr: %s)", err, stderr)
 	}
@@ -275,6 +365,30 @@ func (g *gitRunner) CommitAll(ctx context.Context, dir string, message string) e
 	return nil
 }
 
+// trailerArgs returns the git commit --trailer arguments for the trailers.
+// Trailers are passed in key order so that the commit message is deterministic.
+func trailerArgs(trailers map[string]string) ([]string, error) {
+	keys := make([]string, 0, len(trailers))
+	for key := range trailers {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var args []string
+	for _, key := range keys {
+		value := trailers[key]
+		if key == "" || strings.ContainsAny(key, ": \t\n") {
+			return nil, fmt.Errorf("invalid trailer key %q", key)
+		}
+		if value == "" || strings.Contains(value, "\n") {
+			return nil, fmt.Errorf("invalid value for trailer %s: %q", key, value)
+		}
+		args = append(args, "--trailer", key+": "+value)
+	}
+
+	return args, nil
+}
+
 // GetDiffStat returns the diff stat output for the given base branch
 func (g *gitRunner) GetDiffStat(ctx context.Context, dir string, base string) (string, error) {
 	if base == "" {
@@ -288,3 +402,74 @@ func (g *gitRunner) GetDiffStat(ctx context.Context, dir string, base string) (s
 
 	return stdout, nil
 }
+
+// BranchExists checks if a branch exists locally or as a remote-tracking branch of origin
+func (g *gitRunner) BranchExists(ctx context.Context, dir string, branchName string) (bool, error) {
+	if branchName == "" {
+		return false, fmt.Errorf("branch name cannot be empty")
+	}
+
+	// Unlike git for-each-ref, git show-ref --verify only matches the exact ref,
+	// not refs under it like refs/heads/feature/foo or refs matching a glob
+	for _, ref := range []string{"refs/heads/" + branchName, "refs/remotes/origin/" + branchName} {
+		_, stderr, err := g.runner.RunInDir(ctx, dir, "git", "show-ref", "--verify", "--quiet", ref)
+		if err == nil {
+			return true, nil
+		}
+
+		// git show-ref exits with 1 when the ref does not exist
+		var exitErr interface{ ExitCode() int }
+		if errors.As(err, &exitErr) && exitErr.ExitCode() == 1 {
+			continue
+		}
+		return false, fmt.Errorf("failed to check branch %s: %w (stderr: %s)", branchName, err, stderr)
+	}
+
+	return false, nil
+}
+
+// Clone clones a repository into path
+func (g *gitRunner) Clone(ctx context.Context, dir string, url string, path string, opts CloneOptions) error {
+	if url == "" {
+		return fmt.Errorf("repository URL cannot be empty")
+	}
+	if path == "" {
+		return fmt.Errorf("clone path cannot be empty")
+	}
+	if opts.Depth < 0 {
+		return fmt.Errorf("depth cannot be negative, got %d", opts.Depth)
+	}
+
+	args := []string{"clone"}
+	if opts.Branch != "" {
+		args = append(args, "--branch", opts.Branch)
+	}
+	if opts.Depth > 0 {
+		args = append(args, "--depth", fmt.Sprintf("%d", opts.Depth))
+	}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	args = append(args, "--", url, path)
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "git", args...)
+	if err != nil {
+		return fmt.Errorf("failed to clone %s into %s: %w (stderr: %s)", url, path, err, stderr)
+	}
+
+	return nil
+}
+
+// GetRemoteURL returns the URL of a remote
+func (g *gitRunner) GetRemoteURL(ctx context.Context, dir string, remote string) (string, error) {
+	if remote == "" {
+		return "", fmt.Errorf("remote name cannot be empty")
+	}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "git", "remote", "get-url", remote)
+	if err != nil {
+		return "", fmt.Errorf("failed to get URL of remote %s: %w (stderr: %s)", remote, err, stderr)
+	}
+
+	return strings.TrimSpace(stdout), nil
+}
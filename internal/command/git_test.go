@@ -3,7 +3,9 @@ package command
 import (
 	"context"
 	"fmt"
+	"os/exec"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -155,6 +157,119 @@ func TestGitRunner_Push(t *testing.T) {
 	}
 }
 
+func TestGitRunner_PushWithOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		branch      string
+		opts        PushOptions
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "pushes branch with force-with-lease",
+			branch: "feature-branch",
+			opts:   PushOptions{ForceWithLease: true},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "push", "-u", "--force-with-lease", "origin", "feature-branch").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:   "pushes with a lease on an expected revision",
+			branch: "feature-branch",
+			opts:   PushOptions{ForceWithLease: true, ExpectedRevision: "def456"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "push", "-u", "--force-with-lease=refs/heads/feature-branch:def456", "origin", "feature-branch").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:        "fails when expected revision is set without force with lease",
+			branch:      "feature-branch",
+			opts:        PushOptions{ExpectedRevision: "def456"},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "expected revision requires force with lease",
+		},
+		{
+			name:   "pushes a revision to the branch",
+			branch: "feature-branch",
+			opts:   PushOptions{Revision: "abc123"},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "push", "-u", "origin", "abc123:refs/heads/feature-branch").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:   "pushes with a timeout",
+			branch: "feature-branch",
+			opts:   PushOptions{Timeout: time.Minute},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "push", "-u", "origin", "feature-branch").
+					DoAndReturn(func(ctx context.Context, dir string, name string, args ...string) (string, string, error) {
+						_, ok := ctx.Deadline()
+						assert.True(t, ok)
+						return "", "", nil
+					})
+			},
+		},
+		{
+			name:        "fails when branch name is empty",
+			branch:      "",
+			opts:        PushOptions{ForceWithLease: true},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "branch name cannot be empty",
+		},
+		{
+			name:        "fails when timeout is negative",
+			branch:      "feature-branch",
+			opts:        PushOptions{Timeout: -time.Second},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "push timeout cannot be negative",
+		},
+		{
+			name:   "fails when the lease is stale",
+			branch: "feature-branch",
+			opts:   PushOptions{ForceWithLease: true},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "push", "-u", "--force-with-lease", "origin", "feature-branch").
+					Return("", "! [rejected] feature-branch -> feature-branch (stale info)", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to push branch feature-branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			err := gitRunner.PushWithOptions(context.Background(), "/test/repo", tt.branch, tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGitRunner_WorktreeAdd(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -834,6 +949,65 @@ func TestGitRunner_CommitEmpty(t *testing.T) {
 	}
 }
 
+func TestGitRunner_CommitEmptyWithOptions(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     string
+		opts        CommitOptions
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:    "creates empty commit with trailers",
+			message: "Start workflow",
+			opts:    CommitOptions{Trailers: map[string]string{"Workflow-Name": "feature-login"}},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "commit", "--allow-empty", "-m", "Start workflow", "--trailer", "Workflow-Name: feature-login").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:        "fails when message is empty",
+			message:     "",
+			opts:        CommitOptions{Trailers: map[string]string{"Workflow-Name": "feature-login"}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "commit message cannot be empty",
+		},
+		{
+			name:        "fails when trailer key has a space",
+			message:     "Start workflow",
+			opts:        CommitOptions{Trailers: map[string]string{"Workflow Name": "feature-login"}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: `invalid trailer key "Workflow Name"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			err := gitRunner.CommitEmptyWithOptions(context.Background(), "/test/repo", tt.message, tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGitRunner_CheckoutFiles(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1009,6 +1183,99 @@ func TestGitRunner_CommitAll(t *testing.T) {
 	}
 }
 
+func TestGitRunner_CommitAllWithOptions(t *testing.T) {
+	trailers := map[string]string{
+		"Workflow-Phase": "implement",
+		"Workflow-Name":  "feature-login",
+		"Claude-Session": "abc123",
+	}
+
+	tests := []struct {
+		name        string
+		opts        CommitOptions
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "commits with trailers in key order",
+			opts: CommitOptions{Trailers: trailers},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "add", "-A").
+					Return("", "", nil)
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "commit", "-m", "Add new feature",
+						"--trailer", "Claude-Session: abc123",
+						"--trailer", "Workflow-Name: feature-login",
+						"--trailer", "Workflow-Phase: implement").
+					Return("", "", nil)
+			},
+		},
+		{
+			name: "commits without trailers",
+			opts: CommitOptions{},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "add", "-A").
+					Return("", "", nil)
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "commit", "-m", "Add new feature").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:        "fails when trailer key has a colon",
+			opts:        CommitOptions{Trailers: map[string]string{"Workflow:Name": "feature-login"}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: `invalid trailer key "Workflow:Name"`,
+		},
+		{
+			name:        "fails when trailer key is empty",
+			opts:        CommitOptions{Trailers: map[string]string{"": "feature-login"}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: `invalid trailer key ""`,
+		},
+		{
+			name:        "fails when trailer value has a newline",
+			opts:        CommitOptions{Trailers: map[string]string{"Workflow-Name": "feature\nlogin"}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "invalid value for trailer Workflow-Name",
+		},
+		{
+			name:        "fails when trailer value is empty",
+			opts:        CommitOptions{Trailers: map[string]string{"Workflow-Name": ""}},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "invalid value for trailer Workflow-Name",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			err := gitRunner.CommitAllWithOptions(context.Background(), "/test/repo", "Add new feature", tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestGitRunner_GetDiffStat(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1089,3 +1356,338 @@ func TestGitRunner_GetDiffStat(t *testing.T) {
 		})
 	}
 }
+
+// exitCodeError is an error of a command that exited with a specific code, like *exec.ExitError
+type exitCodeError struct {
+	code int
+}
+
+func (e *exitCodeError) Error() string {
+	return fmt.Sprintf("exit status %d", e.code)
+}
+
+func (e *exitCodeError) ExitCode() int {
+	return e.code
+}
+
+func TestGitRunner_BranchExists(t *testing.T) {
+	notFound := &exitCodeError{code: 1}
+
+	tests := []struct {
+		name        string
+		branchName  string
+		setupMock   func(*MockRunner)
+		want        bool
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:       "returns true when local branch exists",
+			branchName: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/heads/feature").
+					Return("", "", nil)
+			},
+			want: true,
+		},
+		{
+			name:       "returns true when only remote branch exists",
+			branchName: "feature",
+			setupMock: func(m *MockRunner) {
+				gomock.InOrder(
+					m.EXPECT().
+						RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/heads/feature").
+						Return("", "", notFound),
+					m.EXPECT().
+						RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/remotes/origin/feature").
+						Return("", "", nil),
+				)
+			},
+			want: true,
+		},
+		{
+			name:       "returns false when branch does not exist",
+			branchName: "feature",
+			setupMock: func(m *MockRunner) {
+				gomock.InOrder(
+					m.EXPECT().
+						RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/heads/feature").
+						Return("", "", notFound),
+					m.EXPECT().
+						RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/remotes/origin/feature").
+						Return("", "", notFound),
+				)
+			},
+			want: false,
+		},
+		{
+			name:        "fails when branch name is empty",
+			branchName:  "",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "branch name cannot be empty",
+		},
+		{
+			name:       "fails when git show-ref fails",
+			branchName: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/heads/feature").
+					Return("", "fatal: not a git repository", &exitCodeError{code: 128})
+			},
+			wantErr:     true,
+			errContains: "failed to check branch feature",
+		},
+		{
+			name:       "fails when git cannot be run",
+			branchName: "feature",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "show-ref", "--verify", "--quiet", "refs/heads/feature").
+					Return("", "", fmt.Errorf("exec: \"git\": executable file not found in $PATH"))
+			},
+			wantErr:     true,
+			errContains: "failed to check branch feature",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			got, err := gitRunner.BranchExists(context.Background(), "/test/repo", tt.branchName)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGitRunner_BranchExists_Repository(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+
+	dir := t.TempDir()
+	runner := NewRunner()
+	for _, args := range [][]string{
+		{"init", "--quiet"},
+		{"-c", "user.name=test", "-c", "user.email=test@example.com", "commit", "--quiet", "--allow-empty", "-m", "initial"},
+		{"branch", "feature/foo"},
+		{"update-ref", "refs/remotes/origin/release/v1", "HEAD"},
+	} {
+		_, stderr, err := runner.RunInDir(context.Background(), dir, "git", args...)
+		require.NoError(t, err, stderr)
+	}
+
+	tests := []struct {
+		name       string
+		branchName string
+		want       bool
+	}{
+		{
+			name:       "local branch",
+			branchName: "feature/foo",
+			want:       true,
+		},
+		{
+			name:       "remote-tracking branch",
+			branchName: "release/v1",
+			want:       true,
+		},
+		{
+			name:       "prefix of a local branch",
+			branchName: "feature",
+			want:       false,
+		},
+		{
+			name:       "prefix of a remote-tracking branch",
+			branchName: "release",
+			want:       false,
+		},
+		{
+			name:       "glob matching a branch",
+			branchName: "feature/*",
+			want:       false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gitRunner := NewGitRunner(runner)
+			got, err := gitRunner.BranchExists(context.Background(), dir, tt.branchName)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGitRunner_Clone(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		path        string
+		opts        CloneOptions
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name: "clones with full history",
+			url:  "https://github.com/owner/repo.git",
+			path: "/tmp/repo",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "clone", "--", "https://github.com/owner/repo.git", "/tmp/repo").
+					Return("", "Cloning into '/tmp/repo'...", nil)
+			},
+		},
+		{
+			name: "clones shallow partial clone of a branch",
+			url:  "https://github.com/owner/repo.git",
+			path: "/tmp/repo",
+			opts: CloneOptions{
+				Branch: "main",
+				Depth:  1,
+				Filter: "blob:none",
+			},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "clone", "--branch", "main", "--depth", "1", "--filter", "blob:none", "--", "https://github.com/owner/repo.git", "/tmp/repo").
+					Return("", "", nil)
+			},
+		},
+		{
+			name:        "fails when URL is empty",
+			url:         "",
+			path:        "/tmp/repo",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "repository URL cannot be empty",
+		},
+		{
+			name:        "fails when path is empty",
+			url:         "https://github.com/owner/repo.git",
+			path:        "",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "clone path cannot be empty",
+		},
+		{
+			name:        "fails when depth is negative",
+			url:         "https://github.com/owner/repo.git",
+			path:        "/tmp/repo",
+			opts:        CloneOptions{Depth: -1},
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "depth cannot be negative, got -1",
+		},
+		{
+			name: "fails when git clone fails",
+			url:  "https://github.com/owner/repo.git",
+			path: "/tmp/repo",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "clone", "--", "https://github.com/owner/repo.git", "/tmp/repo").
+					Return("", "fatal: destination path '/tmp/repo' already exists and is not an empty directory.", fmt.Errorf("exit status 128"))
+			},
+			wantErr:     true,
+			errContains: "failed to clone https://github.com/owner/repo.git into /tmp/repo",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			err := gitRunner.Clone(context.Background(), "/test/repo", tt.url, tt.path, tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGitRunner_GetRemoteURL(t *testing.T) {
+	tests := []struct {
+		name        string
+		remote      string
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "returns remote URL",
+			remote: "origin",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "remote", "get-url", "origin").
+					Return("git@github.com:owner/repo.git\n", "", nil)
+			},
+			want: "git@github.com:owner/repo.git",
+		},
+		{
+			name:        "fails when remote is empty",
+			remote:      "",
+			setupMock:   func(m *MockRunner) {},
+			wantErr:     true,
+			errContains: "remote name cannot be empty",
+		},
+		{
+			name:   "fails when remote does not exist",
+			remote: "upstream",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "git", "remote", "get-url", "upstream").
+					Return("", "error: No such remote 'upstream'", fmt.Errorf("exit status 2"))
+			},
+			wantErr:     true,
+			errContains: "failed to get URL of remote upstream",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitRunner := NewGitRunner(mockRunner)
+			got, err := gitRunner.GetRemoteURL(context.Background(), "/test/repo", tt.remote)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,133 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// GitLabRunner abstracts glab CLI command execution for testing
+type GitLabRunner interface {
+	// MRCreate creates a new merge request and returns the MR URL
+	MRCreate(ctx context.Context, dir string, title, description, source, target string) (mrURL string, err error)
+	// MRUpdate updates the description of an existing merge request
+	MRUpdate(ctx context.Context, dir string, mrIID int, description string) error
+	// MRClose closes a merge request
+	MRClose(ctx context.Context, dir string, mrIID int) error
+	// GetMRTargetBranch returns the target branch name for a merge request
+	GetMRTargetBranch(ctx context.Context, dir string, mrIID string) (string, error)
+	// GetPipelineStatus returns the status of the latest pipeline for a branch
+	GetPipelineStatus(ctx context.Context, dir string, branch string) (string, error)
+}
+
+// gitLabRunner implements GitLabRunner interface
+type gitLabRunner struct {
+	runner Runner
+}
+
+// NewGitLabRunner creates a new glab runner
+func NewGitLabRunner(runner Runner) GitLabRunner {
+	return &gitLabRunner{
+		runner: runner,
+	}
+}
+
+// MRCreate creates a new merge request and returns the MR URL
+func (g *gitLabRunner) MRCreate(ctx context.Context, dir string, title, description, source, target string) (string, error) {
+	if title == "" {
+		return "", fmt.Errorf("title cannot be empty")
+	}
+	if source == "" {
+		return "", fmt.Errorf("source branch cannot be empty")
+	}
+
+	args := []string{"mr", "create", "--title", title, "--description", description, "--source-branch", source, "--yes"}
+	if target != "" {
+		args = append(args, "--target-branch", target)
+	}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "glab", args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to create MR: %w (stderr: %s)", err, stderr)
+	}
+
+	// glab prints progress lines before the MR URL, which is always last
+	lines := strings.Split(strings.TrimSpace(stdout), "\n")
+	return strings.TrimSpace(lines[len(lines)-1]), nil
+}
+
+// MRUpdate updates the description of an existing merge request
+func (g *gitLabRunner) MRUpdate(ctx context.Context, dir string, mrIID int, description string) error {
+	if mrIID <= 0 {
+		return fmt.Errorf("MR IID must be positive, got %d", mrIID)
+	}
+
+	args := []string{"mr", "update", fmt.Sprintf("%d", mrIID), "--description", description}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "glab", args...)
+	if err != nil {
+		return fmt.Errorf("failed to update MR %d: %w (stderr: %s)", mrIID, err, stderr)
+	}
+
+	return nil
+}
+
+// MRClose closes a merge request
+func (g *gitLabRunner) MRClose(ctx context.Context, dir string, mrIID int) error {
+	if mrIID <= 0 {
+		return fmt.Errorf("MR IID must be positive, got %d", mrIID)
+	}
+
+	args := []string{"mr", "close", fmt.Sprintf("%d", mrIID)}
+
+	_, stderr, err := g.runner.RunInDir(ctx, dir, "glab", args...)
+	if err != nil {
+		return fmt.Errorf("failed to close MR %d: %w (stderr: %s)", mrIID, err, stderr)
+	}
+
+	return nil
+}
+
+// GetMRTargetBranch returns the target branch name for the specified MR IID
+func (g *gitLabRunner) GetMRTargetBranch(ctx context.Context, dir string, mrIID string) (string, error) {
+	args := []string{"mr", "view", mrIID, "--output", "json"}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "glab", args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get MR target branch: %w (stderr: %s)", err, stderr)
+	}
+
+	var mr struct {
+		TargetBranch string `json:"target_branch"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &mr); err != nil {
+		return "", fmt.Errorf("failed to parse MR target branch from output: %w", err)
+	}
+
+	return mr.TargetBranch, nil
+}
+
+// GetPipelineStatus returns the status of the latest pipeline for a branch
+// (e.g. "running", "success", "failed")
+func (g *gitLabRunner) GetPipelineStatus(ctx context.Context, dir string, branch string) (string, error) {
+	if branch == "" {
+		return "", fmt.Errorf("branch name cannot be empty")
+	}
+
+	args := []string{"ci", "get", "--branch", branch, "--output", "json"}
+
+	stdout, stderr, err := g.runner.RunInDir(ctx, dir, "glab", args...)
+	if err != nil {
+		return "", fmt.Errorf("failed to get pipeline status for %s: %w (stderr: %s)", branch, err, stderr)
+	}
+
+	var pipeline struct {
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal([]byte(stdout), &pipeline); err != nil {
+		return "", fmt.Errorf("failed to parse pipeline status from output: %w", err)
+	}
+
+	return pipeline.Status, nil
+}
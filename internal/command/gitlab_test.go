@@ -0,0 +1,414 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestNewGitLabRunner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockRunner(ctrl)
+	got := NewGitLabRunner(mockRunner)
+
+	require.NotNil(t, got)
+}
+
+func TestGitLabRunner_MRCreate(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		title       string
+		description string
+		source      string
+		target      string
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "creates MR successfully without target branch",
+			dir:         "/test/repo",
+			title:       "Test MR",
+			description: "Test description",
+			source:      "feature-branch",
+			target:      "",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "create", "--title", "Test MR", "--description", "Test description", "--source-branch", "feature-branch", "--yes").
+					Return("https://gitlab.com/owner/repo/-/merge_requests/12\n", "", nil)
+			},
+			want:    "https://gitlab.com/owner/repo/-/merge_requests/12",
+			wantErr: false,
+		},
+		{
+			name:        "creates MR successfully with target branch and progress output",
+			dir:         "/test/repo",
+			title:       "Test MR",
+			description: "Test description",
+			source:      "feature-branch",
+			target:      "develop",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "create", "--title", "Test MR", "--description", "Test description", "--source-branch", "feature-branch", "--yes", "--target-branch", "develop").
+					Return("\nCreating merge request for feature-branch into develop in owner/repo\n\nhttps://gitlab.com/owner/repo/-/merge_requests/12\n", "", nil)
+			},
+			want:    "https://gitlab.com/owner/repo/-/merge_requests/12",
+			wantErr: false,
+		},
+		{
+			name:        "fails when glab command fails",
+			dir:         "/test/repo",
+			title:       "Test MR",
+			description: "Test description",
+			source:      "feature-branch",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "create", "--title", "Test MR", "--description", "Test description", "--source-branch", "feature-branch", "--yes").
+					Return("", "error: failed to create MR", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to create MR",
+		},
+		{
+			name:        "fails when title is empty",
+			dir:         "/test/repo",
+			title:       "",
+			description: "Test description",
+			source:      "feature-branch",
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "title cannot be empty",
+		},
+		{
+			name:        "fails when source is empty",
+			dir:         "/test/repo",
+			title:       "Test MR",
+			description: "Test description",
+			source:      "",
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "source branch cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitLabRunner := NewGitLabRunner(mockRunner)
+			ctx := context.Background()
+
+			got, err := gitLabRunner.MRCreate(ctx, tt.dir, tt.title, tt.description, tt.source, tt.target)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGitLabRunner_MRUpdate(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		mrIID       int
+		description string
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "updates MR successfully",
+			dir:         "/test/repo",
+			mrIID:       12,
+			description: "Updated description",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "update", "12", "--description", "Updated description").
+					Return("", "", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:        "fails when glab command fails",
+			dir:         "/test/repo",
+			mrIID:       12,
+			description: "Updated description",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "update", "12", "--description", "Updated description").
+					Return("", "error: merge request not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to update MR 12",
+		},
+		{
+			name:        "fails when MR IID is zero",
+			dir:         "/test/repo",
+			mrIID:       0,
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "MR IID must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitLabRunner := NewGitLabRunner(mockRunner)
+			ctx := context.Background()
+
+			err := gitLabRunner.MRUpdate(ctx, tt.dir, tt.mrIID, tt.description)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGitLabRunner_MRClose(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		mrIID       int
+		setupMock   func(*MockRunner)
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "closes MR successfully",
+			dir:   "/test/repo",
+			mrIID: 12,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "close", "12").
+					Return("", "", nil)
+			},
+			wantErr: false,
+		},
+		{
+			name:  "fails when glab command fails",
+			dir:   "/test/repo",
+			mrIID: 12,
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "close", "12").
+					Return("", "error: merge request not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to close MR 12",
+		},
+		{
+			name:        "fails when MR IID is negative",
+			dir:         "/test/repo",
+			mrIID:       -1,
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "MR IID must be positive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitLabRunner := NewGitLabRunner(mockRunner)
+			ctx := context.Background()
+
+			err := gitLabRunner.MRClose(ctx, tt.dir, tt.mrIID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGitLabRunner_GetMRTargetBranch(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		mrIID       string
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:  "gets target branch successfully",
+			dir:   "/test/repo",
+			mrIID: "12",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "view", "12", "--output", "json").
+					Return(`{"iid":12,"source_branch":"feature","target_branch":"main"}`, "", nil)
+			},
+			want:    "main",
+			wantErr: false,
+		},
+		{
+			name:  "fails when glab command fails",
+			dir:   "/test/repo",
+			mrIID: "12",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "view", "12", "--output", "json").
+					Return("", "error: merge request not found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to get MR target branch",
+		},
+		{
+			name:  "fails when JSON is invalid",
+			dir:   "/test/repo",
+			mrIID: "12",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "mr", "view", "12", "--output", "json").
+					Return(`invalid json`, "", nil)
+			},
+			wantErr:     true,
+			errContains: "failed to parse MR target branch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitLabRunner := NewGitLabRunner(mockRunner)
+			ctx := context.Background()
+
+			got, err := gitLabRunner.GetMRTargetBranch(ctx, tt.dir, tt.mrIID)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGitLabRunner_GetPipelineStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		dir         string
+		branch      string
+		setupMock   func(*MockRunner)
+		want        string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "gets pipeline status successfully",
+			dir:    "/test/repo",
+			branch: "feature-branch",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "ci", "get", "--branch", "feature-branch", "--output", "json").
+					Return(`{"id":1001,"status":"running","ref":"feature-branch"}`, "", nil)
+			},
+			want:    "running",
+			wantErr: false,
+		},
+		{
+			name:   "fails when glab command fails",
+			dir:    "/test/repo",
+			branch: "feature-branch",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "ci", "get", "--branch", "feature-branch", "--output", "json").
+					Return("", "error: no pipeline found", fmt.Errorf("exit status 1"))
+			},
+			wantErr:     true,
+			errContains: "failed to get pipeline status for feature-branch",
+		},
+		{
+			name:   "fails when JSON is invalid",
+			dir:    "/test/repo",
+			branch: "feature-branch",
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().
+					RunInDir(gomock.Any(), "/test/repo", "glab", "ci", "get", "--branch", "feature-branch", "--output", "json").
+					Return(`invalid json`, "", nil)
+			},
+			wantErr:     true,
+			errContains: "failed to parse pipeline status",
+		},
+		{
+			name:        "fails when branch is empty",
+			dir:         "/test/repo",
+			branch:      "",
+			setupMock:   func(_ *MockRunner) {},
+			wantErr:     true,
+			errContains: "branch name cannot be empty",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			gitLabRunner := NewGitLabRunner(mockRunner)
+			ctx := context.Background()
+
+			got, err := gitLabRunner.GetPipelineStatus(ctx, tt.dir, tt.branch)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -40,6 +40,21 @@ func (m *MockGhRunner) EXPECT() *MockGhRunnerMockRecorder {
 	return m.recorder
 }
 
+// FindOpenPR mocks base method.
+func (m *MockGhRunner) FindOpenPR(ctx context.Context, dir, head string) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenPR", ctx, dir, head)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenPR indicates an expected call of FindOpenPR.
+func (mr *MockGhRunnerMockRecorder) FindOpenPR(ctx, dir, head any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenPR", reflect.TypeOf((*MockGhRunner)(nil).FindOpenPR), ctx, dir, head)
+}
+
 // GetLatestRunID mocks base method.
 func (m *MockGhRunner) GetLatestRunID(ctx context.Context, dir string, prNumber int) (int64, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,34 @@ func (mr *MockGhRunnerMockRecorder) GetPRBaseBranch(ctx, dir, prNumber any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPRBaseBranch", reflect.TypeOf((*MockGhRunner)(nil).GetPRBaseBranch), ctx, dir, prNumber)
 }
 
+// PRAddAssignees mocks base method.
+func (m *MockGhRunner) PRAddAssignees(ctx context.Context, dir string, prNumber int, assignees []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PRAddAssignees", ctx, dir, prNumber, assignees)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PRAddAssignees indicates an expected call of PRAddAssignees.
+func (mr *MockGhRunnerMockRecorder) PRAddAssignees(ctx, dir, prNumber, assignees any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRAddAssignees", reflect.TypeOf((*MockGhRunner)(nil).PRAddAssignees), ctx, dir, prNumber, assignees)
+}
+
+// PRAddReviewers mocks base method.
+func (m *MockGhRunner) PRAddReviewers(ctx context.Context, dir string, prNumber int, reviewers []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PRAddReviewers", ctx, dir, prNumber, reviewers)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PRAddReviewers indicates an expected call of PRAddReviewers.
+func (mr *MockGhRunnerMockRecorder) PRAddReviewers(ctx, dir, prNumber, reviewers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRAddReviewers", reflect.TypeOf((*MockGhRunner)(nil).PRAddReviewers), ctx, dir, prNumber, reviewers)
+}
+
 // PRChecks mocks base method.
 func (m *MockGhRunner) PRChecks(ctx context.Context, dir string, prNumber int, jsonFields string) (string, error) {
 	m.ctrl.T.Helper()
@@ -114,6 +157,21 @@ func (mr *MockGhRunnerMockRecorder) PRCreate(ctx, dir, title, body, head, base a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRCreate", reflect.TypeOf((*MockGhRunner)(nil).PRCreate), ctx, dir, title, body, head, base)
 }
 
+// PRCreateWithOptions mocks base method.
+func (m *MockGhRunner) PRCreateWithOptions(ctx context.Context, dir string, opts PRCreateOptions) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PRCreateWithOptions", ctx, dir, opts)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PRCreateWithOptions indicates an expected call of PRCreateWithOptions.
+func (mr *MockGhRunnerMockRecorder) PRCreateWithOptions(ctx, dir, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRCreateWithOptions", reflect.TypeOf((*MockGhRunner)(nil).PRCreateWithOptions), ctx, dir, opts)
+}
+
 // PREdit mocks base method.
 func (m *MockGhRunner) PREdit(ctx context.Context, dir string, prNumber int, body string) error {
 	m.ctrl.T.Helper()
@@ -128,6 +186,34 @@ func (mr *MockGhRunnerMockRecorder) PREdit(ctx, dir, prNumber, body any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PREdit", reflect.TypeOf((*MockGhRunner)(nil).PREdit), ctx, dir, prNumber, body)
 }
 
+// PREditTitle mocks base method.
+func (m *MockGhRunner) PREditTitle(ctx context.Context, dir string, prNumber int, title string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PREditTitle", ctx, dir, prNumber, title)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PREditTitle indicates an expected call of PREditTitle.
+func (mr *MockGhRunnerMockRecorder) PREditTitle(ctx, dir, prNumber, title any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PREditTitle", reflect.TypeOf((*MockGhRunner)(nil).PREditTitle), ctx, dir, prNumber, title)
+}
+
+// PRReady mocks base method.
+func (m *MockGhRunner) PRReady(ctx context.Context, dir string, prNumber int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PRReady", ctx, dir, prNumber)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PRReady indicates an expected call of PRReady.
+func (mr *MockGhRunnerMockRecorder) PRReady(ctx, dir, prNumber any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PRReady", reflect.TypeOf((*MockGhRunner)(nil).PRReady), ctx, dir, prNumber)
+}
+
 // PRView mocks base method.
 func (m *MockGhRunner) PRView(ctx context.Context, dir, jsonFields, jqQuery string) (string, error) {
 	m.ctrl.T.Helper()
@@ -156,3 +242,18 @@ func (mr *MockGhRunnerMockRecorder) RunRerun(ctx, dir, runID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunRerun", reflect.TypeOf((*MockGhRunner)(nil).RunRerun), ctx, dir, runID)
 }
+
+// RunViewLogFailed mocks base method.
+func (m *MockGhRunner) RunViewLogFailed(ctx context.Context, dir string, runID int64) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunViewLogFailed", ctx, dir, runID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunViewLogFailed indicates an expected call of RunViewLogFailed.
+func (mr *MockGhRunnerMockRecorder) RunViewLogFailed(ctx, dir, runID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunViewLogFailed", reflect.TypeOf((*MockGhRunner)(nil).RunViewLogFailed), ctx, dir, runID)
+}
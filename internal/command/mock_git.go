@@ -40,6 +40,21 @@ func (m *MockGitRunner) EXPECT() *MockGitRunnerMockRecorder {
 	return m.recorder
 }
 
+// BranchExists mocks base method.
+func (m *MockGitRunner) BranchExists(ctx context.Context, dir, branchName string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BranchExists", ctx, dir, branchName)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BranchExists indicates an expected call of BranchExists.
+func (mr *MockGitRunnerMockRecorder) BranchExists(ctx, dir, branchName any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BranchExists", reflect.TypeOf((*MockGitRunner)(nil).BranchExists), ctx, dir, branchName)
+}
+
 // CheckoutBranch mocks base method.
 func (m *MockGitRunner) CheckoutBranch(ctx context.Context, dir, branchName string) error {
 	m.ctrl.T.Helper()
@@ -82,6 +97,20 @@ func (mr *MockGitRunnerMockRecorder) CherryPick(ctx, dir, commitHash any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CherryPick", reflect.TypeOf((*MockGitRunner)(nil).CherryPick), ctx, dir, commitHash)
 }
 
+// Clone mocks base method.
+func (m *MockGitRunner) Clone(ctx context.Context, dir, url, path string, opts CloneOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Clone", ctx, dir, url, path, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Clone indicates an expected call of Clone.
+func (mr *MockGitRunnerMockRecorder) Clone(ctx, dir, url, path, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Clone", reflect.TypeOf((*MockGitRunner)(nil).Clone), ctx, dir, url, path, opts)
+}
+
 // CommitAll mocks base method.
 func (m *MockGitRunner) CommitAll(ctx context.Context, dir, message string) error {
 	m.ctrl.T.Helper()
@@ -96,6 +125,20 @@ func (mr *MockGitRunnerMockRecorder) CommitAll(ctx, dir, message any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitAll", reflect.TypeOf((*MockGitRunner)(nil).CommitAll), ctx, dir, message)
 }
 
+// CommitAllWithOptions mocks base method.
+func (m *MockGitRunner) CommitAllWithOptions(ctx context.Context, dir, message string, opts CommitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitAllWithOptions", ctx, dir, message, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitAllWithOptions indicates an expected call of CommitAllWithOptions.
+func (mr *MockGitRunnerMockRecorder) CommitAllWithOptions(ctx, dir, message, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitAllWithOptions", reflect.TypeOf((*MockGitRunner)(nil).CommitAllWithOptions), ctx, dir, message, opts)
+}
+
 // CommitEmpty mocks base method.
 func (m *MockGitRunner) CommitEmpty(ctx context.Context, dir, message string) error {
 	m.ctrl.T.Helper()
@@ -110,6 +153,20 @@ func (mr *MockGitRunnerMockRecorder) CommitEmpty(ctx, dir, message any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitEmpty", reflect.TypeOf((*MockGitRunner)(nil).CommitEmpty), ctx, dir, message)
 }
 
+// CommitEmptyWithOptions mocks base method.
+func (m *MockGitRunner) CommitEmptyWithOptions(ctx context.Context, dir, message string, opts CommitOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CommitEmptyWithOptions", ctx, dir, message, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CommitEmptyWithOptions indicates an expected call of CommitEmptyWithOptions.
+func (mr *MockGitRunnerMockRecorder) CommitEmptyWithOptions(ctx, dir, message, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CommitEmptyWithOptions", reflect.TypeOf((*MockGitRunner)(nil).CommitEmptyWithOptions), ctx, dir, message, opts)
+}
+
 // CreateBranch mocks base method.
 func (m *MockGitRunner) CreateBranch(ctx context.Context, dir, branchName, baseBranch string) error {
 	m.ctrl.T.Helper()
@@ -197,6 +254,21 @@ func (mr *MockGitRunnerMockRecorder) GetDiffStat(ctx, dir, base any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiffStat", reflect.TypeOf((*MockGitRunner)(nil).GetDiffStat), ctx, dir, base)
 }
 
+// GetRemoteURL mocks base method.
+func (m *MockGitRunner) GetRemoteURL(ctx context.Context, dir, remote string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRemoteURL", ctx, dir, remote)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRemoteURL indicates an expected call of GetRemoteURL.
+func (mr *MockGitRunnerMockRecorder) GetRemoteURL(ctx, dir, remote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteURL", reflect.TypeOf((*MockGitRunner)(nil).GetRemoteURL), ctx, dir, remote)
+}
+
 // Push mocks base method.
 func (m *MockGitRunner) Push(ctx context.Context, dir, branch string) error {
 	m.ctrl.T.Helper()
@@ -211,6 +283,20 @@ func (mr *MockGitRunnerMockRecorder) Push(ctx, dir, branch any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Push", reflect.TypeOf((*MockGitRunner)(nil).Push), ctx, dir, branch)
 }
 
+// PushWithOptions mocks base method.
+func (m *MockGitRunner) PushWithOptions(ctx context.Context, dir, branch string, opts PushOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PushWithOptions", ctx, dir, branch, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PushWithOptions indicates an expected call of PushWithOptions.
+func (mr *MockGitRunnerMockRecorder) PushWithOptions(ctx, dir, branch, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PushWithOptions", reflect.TypeOf((*MockGitRunner)(nil).PushWithOptions), ctx, dir, branch, opts)
+}
+
 // WorktreeAdd mocks base method.
 func (m *MockGitRunner) WorktreeAdd(ctx context.Context, dir, path, branch string) error {
 	m.ctrl.T.Helper()
@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: gitlab.go
+//
+// Generated by this command:
+//
+//	mockgen -source=gitlab.go -destination=mock_gitlab.go -package=command
+//
+
+// Package command is a generated GoMock package.
+package command
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockGitLabRunner is a mock of GitLabRunner interface.
+type MockGitLabRunner struct {
+	ctrl     *gomock.Controller
+	recorder *MockGitLabRunnerMockRecorder
+	isgomock struct{}
+}
+
+// MockGitLabRunnerMockRecorder is the mock recorder for MockGitLabRunner.
+type MockGitLabRunnerMockRecorder struct {
+	mock *MockGitLabRunner
+}
+
+// NewMockGitLabRunner creates a new mock instance.
+func NewMockGitLabRunner(ctrl *gomock.Controller) *MockGitLabRunner {
+	mock := &MockGitLabRunner{ctrl: ctrl}
+	mock.recorder = &MockGitLabRunnerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockGitLabRunner) EXPECT() *MockGitLabRunnerMockRecorder {
+	return m.recorder
+}
+
+// GetMRTargetBranch mocks base method.
+func (m *MockGitLabRunner) GetMRTargetBranch(ctx context.Context, dir, mrIID string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMRTargetBranch", ctx, dir, mrIID)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMRTargetBranch indicates an expected call of GetMRTargetBranch.
+func (mr *MockGitLabRunnerMockRecorder) GetMRTargetBranch(ctx, dir, mrIID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMRTargetBranch", reflect.TypeOf((*MockGitLabRunner)(nil).GetMRTargetBranch), ctx, dir, mrIID)
+}
+
+// GetPipelineStatus mocks base method.
+func (m *MockGitLabRunner) GetPipelineStatus(ctx context.Context, dir, branch string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPipelineStatus", ctx, dir, branch)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPipelineStatus indicates an expected call of GetPipelineStatus.
+func (mr *MockGitLabRunnerMockRecorder) GetPipelineStatus(ctx, dir, branch any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPipelineStatus", reflect.TypeOf((*MockGitLabRunner)(nil).GetPipelineStatus), ctx, dir, branch)
+}
+
+// MRClose mocks base method.
+func (m *MockGitLabRunner) MRClose(ctx context.Context, dir string, mrIID int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MRClose", ctx, dir, mrIID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MRClose indicates an expected call of MRClose.
+func (mr *MockGitLabRunnerMockRecorder) MRClose(ctx, dir, mrIID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MRClose", reflect.TypeOf((*MockGitLabRunner)(nil).MRClose), ctx, dir, mrIID)
+}
+
+// MRCreate mocks base method.
+func (m *MockGitLabRunner) MRCreate(ctx context.Context, dir, title, description, source, target string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MRCreate", ctx, dir, title, description, source, target)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MRCreate indicates an expected call of MRCreate.
+func (mr *MockGitLabRunnerMockRecorder) MRCreate(ctx, dir, title, description, source, target any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MRCreate", reflect.TypeOf((*MockGitLabRunner)(nil).MRCreate), ctx, dir, title, description, source, target)
+}
+
+// MRUpdate mocks base method.
+func (m *MockGitLabRunner) MRUpdate(ctx context.Context, dir string, mrIID int, description string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MRUpdate", ctx, dir, mrIID, description)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MRUpdate indicates an expected call of MRUpdate.
+func (mr *MockGitLabRunnerMockRecorder) MRUpdate(ctx, dir, mrIID, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MRUpdate", reflect.TypeOf((*MockGitLabRunner)(nil).MRUpdate), ctx, dir, mrIID, description)
+}
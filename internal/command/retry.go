@@ -0,0 +1,116 @@
+package command
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// transientErrorPatterns are lowercase stderr fragments of network failures that may succeed on retry
+var transientErrorPatterns = []string{
+	"could not resolve host",
+	"temporary failure in name resolution",
+	"no such host",
+	"network is unreachable",
+	"connection reset by peer",
+	"connection timed out",
+	"operation timed out",
+	"i/o timeout",
+	"tls handshake timeout",
+	"the remote end hung up unexpectedly",
+	"early eof",
+	"http 500",
+	"http 502",
+	"http 503",
+	"http 504",
+	"returned error: 500",
+	"returned error: 502",
+	"returned error: 503",
+	"returned error: 504",
+}
+
+// IsTransientError checks if the stderr of a failed command indicates a transient network error,
+// such as a DNS failure or a 5xx response from the API, rather than a logical failure
+func IsTransientError(stderr string) bool {
+	lower := strings.ToLower(stderr)
+	for _, pattern := range transientErrorPatterns {
+		if strings.Contains(lower, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// RetryOptions holds options for retrying commands that fail with transient errors
+type RetryOptions struct {
+	MaxAttempts    int           // Maximum number of attempts including the first one (default: 3)
+	InitialBackoff time.Duration // Delay before the first retry, doubled for each further retry (default: 1s)
+	MaxBackoff     time.Duration // Upper bound of the delay between retries (default: 10s)
+}
+
+// retryRunner implements Runner by retrying commands that fail with transient errors
+type retryRunner struct {
+	runner Runner
+	opts   RetryOptions
+	sleep  func(ctx context.Context, d time.Duration) error
+}
+
+// NewRetryRunner creates a runner that retries commands failing with transient network errors
+// with exponential backoff. Other failures are returned immediately.
+// A command is run again after a transient failure, so only wrap runners for commands that are safe to repeat.
+func NewRetryRunner(runner Runner, opts RetryOptions) Runner {
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = 3
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = time.Second
+	}
+	if opts.MaxBackoff <= 0 {
+		opts.MaxBackoff = 10 * time.Second
+	}
+
+	return &retryRunner{
+		runner: runner,
+		opts:   opts,
+		sleep:  sleepContext,
+	}
+}
+
+// Run executes a command and returns stdout, stderr, and error
+func (r *retryRunner) Run(ctx context.Context, name string, args ...string) (string, string, error) {
+	return r.RunInDir(ctx, "", name, args...)
+}
+
+// RunInDir executes a command in a specific directory, retrying transient failures
+func (r *retryRunner) RunInDir(ctx context.Context, dir string, name string, args ...string) (string, string, error) {
+	backoff := r.opts.InitialBackoff
+
+	for attempt := 1; ; attempt++ {
+		stdout, stderr, err := r.runner.RunInDir(ctx, dir, name, args...)
+		if err == nil || attempt >= r.opts.MaxAttempts || !IsTransientError(stderr) {
+			return stdout, stderr, err
+		}
+
+		if sleepErr := r.sleep(ctx, backoff); sleepErr != nil {
+			return stdout, stderr, err
+		}
+
+		backoff *= 2
+		if backoff > r.opts.MaxBackoff {
+			backoff = r.opts.MaxBackoff
+		}
+	}
+}
+
+// sleepContext waits for the duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
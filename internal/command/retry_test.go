@@ -0,0 +1,236 @@
+package command
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestIsTransientError(t *testing.T) {
+	tests := []struct {
+		name   string
+		stderr string
+		want   bool
+	}{
+		{
+			name:   "DNS failure from git",
+			stderr: "fatal: unable to access 'https://github.com/owner/repo.git/': Could not resolve host: github.com",
+			want:   true,
+		},
+		{
+			name:   "DNS failure from gh",
+			stderr: "error connecting to api.github.com\ndial tcp: lookup api.github.com: no such host",
+			want:   true,
+		},
+		{
+			name:   "5xx response from gh",
+			stderr: "HTTP 502: Bad Gateway (https://api.github.com/graphql)",
+			want:   true,
+		},
+		{
+			name:   "5xx response from git over HTTPS",
+			stderr: "fatal: unable to access 'https://github.com/owner/repo.git/': The requested URL returned error: 503",
+			want:   true,
+		},
+		{
+			name:   "connection reset",
+			stderr: "read tcp 10.0.0.2:53412->140.82.112.3:443: read: connection reset by peer",
+			want:   true,
+		},
+		{
+			name:   "remote hung up",
+			stderr: "fatal: the remote end hung up unexpectedly",
+			want:   true,
+		},
+		{
+			name:   "not found is a logical failure",
+			stderr: "HTTP 404: Not Found (https://api.github.com/repos/owner/repo/pulls/1)",
+			want:   false,
+		},
+		{
+			name:   "authentication failure is a logical failure",
+			stderr: "HTTP 401: Bad credentials (https://api.github.com/graphql)",
+			want:   false,
+		},
+		{
+			name:   "rejected push is a logical failure",
+			stderr: "! [rejected] main -> main (non-fast-forward)",
+			want:   false,
+		},
+		{
+			name:   "empty stderr",
+			stderr: "",
+			want:   false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := IsTransientError(tt.stderr)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewRetryRunner(t *testing.T) {
+	tests := []struct {
+		name string
+		opts RetryOptions
+		want RetryOptions
+	}{
+		{
+			name: "applies defaults",
+			opts: RetryOptions{},
+			want: RetryOptions{
+				MaxAttempts:    3,
+				InitialBackoff: time.Second,
+				MaxBackoff:     10 * time.Second,
+			},
+		},
+		{
+			name: "keeps custom options",
+			opts: RetryOptions{
+				MaxAttempts:    5,
+				InitialBackoff: 100 * time.Millisecond,
+				MaxBackoff:     time.Second,
+			},
+			want: RetryOptions{
+				MaxAttempts:    5,
+				InitialBackoff: 100 * time.Millisecond,
+				MaxBackoff:     time.Second,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := NewRetryRunner(NewRunner(), tt.opts)
+			require.NotNil(t, got)
+			assert.Equal(t, tt.want, got.(*retryRunner).opts)
+		})
+	}
+}
+
+func TestRetryRunner_RunInDir(t *testing.T) {
+	transientErr := fmt.Errorf("exit status 128")
+	transientStderr := "fatal: unable to access 'https://github.com/owner/repo.git/': Could not resolve host: github.com"
+
+	tests := []struct {
+		name         string
+		opts         RetryOptions
+		setupMock    func(*MockRunner)
+		sleepErr     error
+		wantStdout   string
+		wantStderr   string
+		wantErr      bool
+		wantBackoffs []time.Duration
+	}{
+		{
+			name: "returns success without retrying",
+			opts: RetryOptions{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("ok", "", nil).Times(1)
+			},
+			wantStdout: "ok",
+		},
+		{
+			name: "retries transient error until success",
+			opts: RetryOptions{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			setupMock: func(m *MockRunner) {
+				gomock.InOrder(
+					m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("", transientStderr, transientErr),
+					m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("", transientStderr, transientErr),
+					m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("ok", "", nil),
+				)
+			},
+			wantStdout:   "ok",
+			wantBackoffs: []time.Duration{time.Second, 2 * time.Second},
+		},
+		{
+			name: "returns last error after max attempts",
+			opts: RetryOptions{MaxAttempts: 4, InitialBackoff: 4 * time.Second, MaxBackoff: 10 * time.Second},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("", transientStderr, transientErr).Times(4)
+			},
+			wantStderr:   transientStderr,
+			wantErr:      true,
+			wantBackoffs: []time.Duration{4 * time.Second, 8 * time.Second, 10 * time.Second},
+		},
+		{
+			name: "does not retry logical failure",
+			opts: RetryOptions{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("", "fatal: couldn't find remote ref feature", transientErr).Times(1)
+			},
+			wantStderr: "fatal: couldn't find remote ref feature",
+			wantErr:    true,
+		},
+		{
+			name: "stops retrying when context is done",
+			opts: RetryOptions{MaxAttempts: 3, InitialBackoff: time.Second, MaxBackoff: 10 * time.Second},
+			setupMock: func(m *MockRunner) {
+				m.EXPECT().RunInDir(gomock.Any(), "/test/repo", "git", "fetch").Return("", transientStderr, transientErr).Times(1)
+			},
+			sleepErr:     context.Canceled,
+			wantStderr:   transientStderr,
+			wantErr:      true,
+			wantBackoffs: []time.Duration{time.Second},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockRunner := NewMockRunner(ctrl)
+			tt.setupMock(mockRunner)
+
+			var gotBackoffs []time.Duration
+			runner := NewRetryRunner(mockRunner, tt.opts).(*retryRunner)
+			runner.sleep = func(ctx context.Context, d time.Duration) error {
+				gotBackoffs = append(gotBackoffs, d)
+				return tt.sleepErr
+			}
+
+			stdout, stderr, err := runner.RunInDir(context.Background(), "/test/repo", "git", "fetch")
+
+			if tt.wantErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.wantStdout, stdout)
+			assert.Equal(t, tt.wantStderr, stderr)
+			assert.Equal(t, tt.wantBackoffs, gotBackoffs)
+		})
+	}
+}
+
+func TestRetryRunner_Run(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockRunner := NewMockRunner(ctrl)
+	mockRunner.EXPECT().RunInDir(gomock.Any(), "", "gh", "auth", "status").Return("Logged in", "", nil)
+
+	runner := NewRetryRunner(mockRunner, RetryOptions{})
+	stdout, _, err := runner.Run(context.Background(), "gh", "auth", "status")
+	require.NoError(t, err)
+	assert.Equal(t, "Logged in", stdout)
+}
+
+func TestSleepContext(t *testing.T) {
+	err := sleepContext(context.Background(), time.Millisecond)
+	assert.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = sleepContext(ctx, time.Hour)
+	assert.ErrorIs(t, err, context.Canceled)
+}
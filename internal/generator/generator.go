@@ -32,6 +32,17 @@ func NewGeneratorWithFS(fsys fs.FS) (*Generator, error) {
 	}, nil
 }
 
+func NewGeneratorWithLayers(layers ...TemplateLayer) (*Generator, error) {
+	engine, err := NewEngineWithLayers(layers...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create engine: %w", err)
+	}
+
+	return &Generator{
+		engine: engine,
+	}, nil
+}
+
 func (g *Generator) Generate(itemType ItemType, name string) error {
 	content, err := g.engine.Generate(itemType, name)
 	if err != nil {
@@ -42,10 +53,22 @@ func (g *Generator) Generate(itemType ItemType, name string) error {
 	return nil
 }
 
+func (g *Generator) GenerateToDir(itemType ItemType, name string, dir string, opts WriteOptions) (string, error) {
+	return g.engine.WriteItem(dir, itemType, name, opts)
+}
+
 func (g *Generator) List(itemType ItemType) []string {
 	return g.engine.List(itemType)
 }
 
+func (g *Generator) Source(itemType ItemType, name string) string {
+	return g.engine.Source(itemType, name)
+}
+
+func (g *Generator) Warnings() []string {
+	return g.engine.Warnings()
+}
+
 func (g *Generator) GenerateAll(itemType ItemType) error {
 	templates := g.engine.List(itemType)
 
@@ -512,3 +512,45 @@ func TestGenerator_InitRulesDirectory(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerator_GenerateToDir(t *testing.T) {
+	fsys := fstest.MapFS{
+		"prompts/commands/feature.tmpl": &fstest.MapFile{
+			Data: []byte(`Command {{.Name}}`),
+		},
+	}
+
+	gen, err := NewGeneratorWithFS(fsys)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	got, err := gen.GenerateToDir(ItemTypeCommand, "feature", dir, WriteOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, filepath.Join(dir, "feature.md"), got)
+
+	content, err := os.ReadFile(got)
+	require.NoError(t, err)
+	assert.Equal(t, "Command feature", string(content))
+
+	_, err = gen.GenerateToDir(ItemTypeCommand, "feature", dir, WriteOptions{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "already exists")
+}
+
+func TestNewGeneratorWithLayers(t *testing.T) {
+	userFS := fstest.MapFS{
+		"prompts/skills/coding.tmpl": &fstest.MapFile{
+			Data: []byte(`User {{.Name}}`),
+		},
+	}
+
+	gen, err := NewGeneratorWithLayers(
+		TemplateLayer{Source: SourceUser, FS: userFS},
+		TemplateLayer{Source: SourceEmbedded, FS: templatesFS},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, SourceUser, gen.Source(ItemTypeSkill, "coding"))
+	assert.Equal(t, SourceEmbedded, gen.Source(ItemTypeSkill, "ci-error-fix"))
+	assert.Contains(t, gen.List(ItemTypeAgent), "code-reviewer")
+}
@@ -0,0 +1,57 @@
+package generator
+
+import (
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// Template sources, in order of precedence.
+const (
+	SourceProject  = "project"  // .claude/templates in the project directory
+	SourceUser     = "user"     // ~/.config/claude-code-tools/templates
+	SourceEmbedded = "embedded" // Templates built into the binary
+)
+
+// TemplateLayer is a source of templates with the same layout as the embedded templates.
+type TemplateLayer struct {
+	Source string // Name of the source reported by Engine.Source, e.g. "project"
+	FS     fs.FS  // File system containing the prompts/ directory
+}
+
+// DefaultTemplateLayers returns the template layers in order of precedence:
+// projectDir/.claude/templates, homeDir/.config/claude-code-tools/templates, and the embedded templates.
+// User directories that do not exist are skipped, as are empty projectDir and homeDir.
+func DefaultTemplateLayers(projectDir string, homeDir string) []TemplateLayer {
+	var layers []TemplateLayer
+
+	candidates := []struct {
+		source string
+		base   string
+		dir    string
+	}{
+		{source: SourceProject, base: projectDir, dir: filepath.Join(".claude", "templates")},
+		{source: SourceUser, base: homeDir, dir: filepath.Join(".config", "claude-code-tools", "templates")},
+	}
+	for _, candidate := range candidates {
+		if candidate.base == "" {
+			continue
+		}
+
+		dir := filepath.Join(candidate.base, candidate.dir)
+		info, err := os.Stat(dir)
+		if err != nil || !info.IsDir() {
+			continue
+		}
+
+		layers = append(layers, TemplateLayer{
+			Source: candidate.source,
+			FS:     os.DirFS(dir),
+		})
+	}
+
+	return append(layers, TemplateLayer{
+		Source: SourceEmbedded,
+		FS:     templatesFS,
+	})
+}
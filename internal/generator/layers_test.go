@@ -0,0 +1,71 @@
+package generator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultTemplateLayers(t *testing.T) {
+	tests := []struct {
+		name        string
+		createDirs  []string
+		emptyBase   bool
+		wantSources []string
+	}{
+		{
+			name:        "only embedded when no user directories exist",
+			wantSources: []string{SourceEmbedded},
+		},
+		{
+			name: "project and user directories take precedence",
+			createDirs: []string{
+				filepath.Join("project", ".claude", "templates"),
+				filepath.Join("home", ".config", "claude-code-tools", "templates"),
+			},
+			wantSources: []string{SourceProject, SourceUser, SourceEmbedded},
+		},
+		{
+			name: "user directory only",
+			createDirs: []string{
+				filepath.Join("home", ".config", "claude-code-tools", "templates"),
+			},
+			wantSources: []string{SourceUser, SourceEmbedded},
+		},
+		{
+			name: "empty base directories are skipped",
+			createDirs: []string{
+				filepath.Join("project", ".claude", "templates"),
+			},
+			emptyBase:   true,
+			wantSources: []string{SourceEmbedded},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+			for _, dir := range tt.createDirs {
+				require.NoError(t, os.MkdirAll(filepath.Join(tmpDir, dir), 0755))
+			}
+
+			projectDir := filepath.Join(tmpDir, "project")
+			homeDir := filepath.Join(tmpDir, "home")
+			if tt.emptyBase {
+				projectDir = ""
+				homeDir = ""
+			}
+
+			got := DefaultTemplateLayers(projectDir, homeDir)
+
+			gotSources := make([]string, 0, len(got))
+			for _, layer := range got {
+				gotSources = append(gotSources, layer.Source)
+			}
+			assert.Equal(t, tt.wantSources, gotSources)
+		})
+	}
+}
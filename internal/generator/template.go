@@ -7,7 +7,9 @@ import (
 	"fmt"
 	"io/fs"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
 
@@ -78,9 +80,11 @@ func pathsToYAML(paths []string) string {
 
 // Engine holds parsed templates and provides generation capabilities
 type Engine struct {
-	templates     map[ItemType]*template.Template
-	templateNames map[ItemType][]string
-	rulesConfig   *RulesConfig
+	templates       map[ItemType]*template.Template
+	templateNames   map[ItemType][]string
+	templateSources map[ItemType]map[string]string
+	rulesConfig     *RulesConfig
+	warnings        []string
 }
 
 // NewEngine creates a new template engine by loading and parsing all templates from embedded FS
@@ -90,94 +94,150 @@ func NewEngine() (*Engine, error) {
 
 // NewEngineWithFS creates a new template engine by loading and parsing all templates from the provided FS
 func NewEngineWithFS(fsys fs.FS) (*Engine, error) {
+	return NewEngineWithLayers(TemplateLayer{FS: fsys})
+}
+
+// NewEngineWithLayers creates a new template engine from layers in order of precedence.
+// A template in an earlier layer overrides the template with the same name in later layers,
+// and the source of each template is recorded so that it can be reported by Source.
+// Partials and rule metadata are merged across layers, so that a layer only needs to contain
+// the definitions it overrides.
+// The last layer is the base: an invalid file in it is an error. An invalid file in another layer
+// is skipped, falling back to the later layers, and reported by Warnings.
+func NewEngineWithLayers(layers ...TemplateLayer) (*Engine, error) {
 	engine := &Engine{
-		templates:     make(map[ItemType]*template.Template),
-		templateNames: make(map[ItemType][]string),
+		templates:       make(map[ItemType]*template.Template),
+		templateNames:   make(map[ItemType][]string),
+		templateSources: make(map[ItemType]map[string]string),
 	}
 
 	itemTypes := []ItemType{ItemTypeSkill, ItemTypeAgent, ItemTypeCommand, ItemTypeRule}
 
 	for _, itemType := range itemTypes {
-		tmpl, names, err := loadTemplatesForType(fsys, itemType)
+		loaded, err := loadTemplatesForType(layers, itemType)
 		if err != nil {
 			return nil, fmt.Errorf("failed to load templates for %s: %w", itemType, err)
 		}
-		engine.templates[itemType] = tmpl
-		engine.templateNames[itemType] = names
+		engine.templates[itemType] = loaded.tmpl
+		engine.templateNames[itemType] = loaded.names
+		engine.templateSources[itemType] = loaded.sources
+		engine.warnings = append(engine.warnings, loaded.warnings...)
 	}
 
-	// Load rules metadata if rules directory exists
-	rulesConfig, err := loadRuleMetadata(fsys)
+	rulesConfig, warnings, err := loadLayeredRuleMetadata(layers)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load rule metadata: %w", err)
 	}
 	engine.rulesConfig = rulesConfig
+	engine.warnings = append(engine.warnings, warnings...)
 
 	return engine, nil
 }
 
-// loadTemplatesForType loads all templates for a specific item type from the provided FS
-func loadTemplatesForType(fsys fs.FS, itemType ItemType) (*template.Template, []string, error) {
-	dir := fmt.Sprintf("prompts/%ss", itemType)
+// Warnings returns the problems of files skipped while loading templates from layers.
+func (e *Engine) Warnings() []string {
+	return e.warnings
+}
 
-	// Check if directory exists
-	entries, err := fs.ReadDir(fsys, dir)
-	if err != nil {
-		// Directory doesn't exist, return empty template set
-		return template.New(string(itemType)), []string{}, nil
-	}
+// loadedTemplates holds the templates of an item type loaded from layers.
+type loadedTemplates struct {
+	tmpl     *template.Template
+	names    []string
+	sources  map[string]string
+	warnings []string
+}
 
-	var tmpl *template.Template
+// layerWarning formats a problem of a file skipped in a layer.
+func layerWarning(layer TemplateLayer, filePath string, err error) string {
+	return fmt.Sprintf("skipped %s from %s templates: %v", filePath, layer.Source, err)
+}
 
-	// First pass: parse type-specific _partials.tmpl from the type's directory
-	typePartialsPath := filepath.Join(dir, "_partials.tmpl")
-	partialsContent, err := fs.ReadFile(fsys, typePartialsPath)
-	if err == nil {
-		tmpl = template.New(string(itemType)).Funcs(template.FuncMap{
-			"pathsToYAML": pathsToYAML,
-		})
-		tmpl, err = tmpl.Parse(string(partialsContent))
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to parse type-specific partials: %w", err)
-		}
-	} else {
-		tmpl = template.New(string(itemType)).Funcs(template.FuncMap{
+// loadTemplatesForType loads all templates for a specific item type from layers in order of precedence.
+// Partials are parsed from the last layer first, so that an earlier layer overrides the definitions it
+// contains and keeps the others. For each template name, the first layer whose file parses is used.
+func loadTemplatesForType(layers []TemplateLayer, itemType ItemType) (*loadedTemplates, error) {
+	dir := fmt.Sprintf("prompts/%ss", itemType)
+
+	loaded := &loadedTemplates{
+		tmpl: template.New(string(itemType)).Funcs(template.FuncMap{
 			"pathsToYAML": pathsToYAML,
-		})
+		}),
+		names:   []string{},
+		sources: make(map[string]string),
 	}
 
-	// Track template names from actual template files (not partials)
-	var templateNames []string
+	// First pass: parse type-specific _partials.tmpl from the type's directory of each layer
+	for i := len(layers) - 1; i >= 0; i-- {
+		layer := layers[i]
+		isBase := i == len(layers)-1
 
-	// Second pass: parse all other .tmpl files
-	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tmpl") {
+		partialsPath := path.Join(dir, "_partials.tmpl")
+		partialsContent, err := fs.ReadFile(layer.FS, partialsPath)
+		if err != nil {
 			continue
 		}
 
-		// Skip _partials.tmpl as it's already parsed
-		if entry.Name() == "_partials.tmpl" {
-			continue
+		// A failed parse does not change the template set, so the partials of later layers are kept
+		if _, err := loaded.tmpl.Parse(string(partialsContent)); err != nil {
+			if isBase {
+				return nil, fmt.Errorf("failed to parse type-specific partials: %w", err)
+			}
+			loaded.warnings = append(loaded.warnings, layerWarning(layer, partialsPath, err))
 		}
+	}
+
+	// Second pass: parse all other .tmpl files, skipping names already loaded from an earlier layer
+	for i, layer := range layers {
+		isBase := i == len(layers)-1
 
-		filePath := filepath.Join(dir, entry.Name())
-		content, err := fs.ReadFile(fsys, filePath)
+		entries, err := fs.ReadDir(layer.FS, dir)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to read template file %s: %w", filePath, err)
+			// Directory doesn't exist in this layer
+			continue
 		}
 
-		// Extract template name from filename (remove .tmpl extension)
-		templateName := strings.TrimSuffix(entry.Name(), ".tmpl")
-		templateNames = append(templateNames, templateName)
+		for _, entry := range entries {
+			if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".tmpl") {
+				continue
+			}
 
-		// Parse template with the derived name
-		_, err = tmpl.New(templateName).Parse(string(content))
-		if err != nil {
-			return nil, nil, fmt.Errorf("failed to parse template %s: %w", filePath, err)
+			// Skip _partials.tmpl as it's already parsed
+			if entry.Name() == "_partials.tmpl" {
+				continue
+			}
+
+			// Extract template name from filename (remove .tmpl extension)
+			templateName := strings.TrimSuffix(entry.Name(), ".tmpl")
+			if _, ok := loaded.sources[templateName]; ok {
+				continue
+			}
+
+			filePath := path.Join(dir, entry.Name())
+			content, err := fs.ReadFile(layer.FS, filePath)
+			if err != nil {
+				if isBase {
+					return nil, fmt.Errorf("failed to read template file %s: %w", filePath, err)
+				}
+				loaded.warnings = append(loaded.warnings, layerWarning(layer, filePath, err))
+				continue
+			}
+
+			// Parse template with the derived name
+			if _, err := loaded.tmpl.New(templateName).Parse(string(content)); err != nil {
+				if isBase {
+					return nil, fmt.Errorf("failed to parse template %s: %w", filePath, err)
+				}
+				loaded.warnings = append(loaded.warnings, layerWarning(layer, filePath, err))
+				continue
+			}
+
+			loaded.names = append(loaded.names, templateName)
+			loaded.sources[templateName] = layer.Source
 		}
 	}
 
-	return tmpl, templateNames, nil
+	sort.Strings(loaded.names)
+	return loaded, nil
 }
 
 // Generate executes a specific template and returns the result
@@ -241,6 +301,12 @@ func (e *Engine) List(itemType ItemType) []string {
 	return names
 }
 
+// Source returns the source of a template, e.g. "project", "user" or "embedded".
+// Returns an empty string if the engine was not created from layers or the template does not exist.
+func (e *Engine) Source(itemType ItemType, name string) string {
+	return e.templateSources[itemType][name]
+}
+
 // GetRulesConfig returns the loaded rules configuration
 func (e *Engine) GetRulesConfig() *RulesConfig {
 	return e.rulesConfig
@@ -309,51 +375,85 @@ func (e *Engine) GenerateRuleWithOptions(name string, opts GenerateOptions) (str
 // Returns an error if directory creation fails, rule generation fails, or if a file exists
 // and force is false.
 func (e *Engine) InitRulesDirectory(dir string, rules []string, force bool) error {
-	if err := os.MkdirAll(dir, 0755); err != nil {
-		return fmt.Errorf("failed to create directory %s: %w", dir, err)
-	}
-
 	for _, ruleName := range rules {
-		content, err := e.Generate(ItemTypeRule, ruleName)
+		outputPath, err := e.WriteItem(dir, ItemTypeRule, ruleName, WriteOptions{Force: force})
 		if err != nil {
-			return fmt.Errorf("failed to generate rule %s: %w", ruleName, err)
+			return err
 		}
 
-		metadata, ok := e.rulesConfig.Rules[ruleName]
-		filename := fmt.Sprintf("%s.md", ruleName)
-		if ok && metadata.Filename != "" {
+		fmt.Printf("Created %s\n", outputPath)
+	}
+
+	return nil
+}
+
+// WriteOptions holds options for writing a generated item to a file.
+type WriteOptions struct {
+	GenerateOptions        // Options for generating rules
+	Filename        string // Output filename (default: the filename returned by ItemPath)
+	Force           bool   // Overwrite an existing file
+}
+
+// ItemPath returns the path of the file for an item written into dir.
+// Rules use the filename from their metadata, and other items are written to {name}.md.
+// Agents, commands, and skills are prompts to create the item rather than its definition,
+// so they should be written outside of the .claude/agents, .claude/commands, and .claude/skills directories.
+func (e *Engine) ItemPath(dir string, itemType ItemType, name string) string {
+	filename := fmt.Sprintf("%s.md", name)
+	if itemType == ItemTypeRule {
+		if metadata, ok := e.rulesConfig.Rules[name]; ok && metadata.Filename != "" {
 			filename = filepath.Base(metadata.Filename)
 		}
+	}
+	return filepath.Join(dir, filename)
+}
 
-		outputPath := filepath.Join(dir, filename)
+// WriteItem generates an item and writes it into dir.
+// It creates the directory as needed and returns the path of the written file.
+// Returns an error if generation fails, or if the file exists and opts.Force is false.
+func (e *Engine) WriteItem(dir string, itemType ItemType, name string, opts WriteOptions) (string, error) {
+	var content string
+	var err error
+	if itemType == ItemTypeRule {
+		content, err = e.GenerateRuleWithOptions(name, opts.GenerateOptions)
+	} else {
+		content, err = e.Generate(itemType, name)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to generate %s %s: %w", itemType, name, err)
+	}
 
-		if !force {
-			if _, err := os.Stat(outputPath); err == nil {
-				return fmt.Errorf("file %s already exists (use --force to overwrite)", outputPath)
-			}
-		}
+	outputPath := e.ItemPath(dir, itemType, name)
+	if opts.Filename != "" {
+		outputPath = filepath.Join(dir, opts.Filename)
+	}
 
-		if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
-			return fmt.Errorf("failed to write file %s: %w", outputPath, err)
+	if !opts.Force {
+		if _, err := os.Stat(outputPath); err == nil {
+			return "", fmt.Errorf("file %s already exists (use --force to overwrite)", outputPath)
 		}
+	}
 
-		fmt.Printf("Created %s\n", outputPath)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf("failed to create directory %s: %w", dir, err)
 	}
 
-	return nil
+	if err := os.WriteFile(outputPath, []byte(content), 0644); err != nil {
+		return "", fmt.Errorf("failed to write file %s: %w", outputPath, err)
+	}
+
+	return outputPath, nil
 }
 
-// loadRuleMetadata loads the _metadata.yaml file from the rules directory
-func loadRuleMetadata(fsys fs.FS) (*RulesConfig, error) {
-	metadataPath := "prompts/rules/_metadata.yaml"
+// ruleMetadataPath is the path of the rule metadata file in a template layer.
+const ruleMetadataPath = "prompts/rules/_metadata.yaml"
 
-	data, err := fs.ReadFile(fsys, metadataPath)
+// readRuleMetadata reads the rule metadata file as written, without defaults.
+// Returns nil if the file doesn't exist.
+func readRuleMetadata(fsys fs.FS) (*RulesConfig, error) {
+	data, err := fs.ReadFile(fsys, ruleMetadataPath)
 	if err != nil {
-		// If metadata file doesn't exist, return empty config
-		return &RulesConfig{
-			DefaultRules: []string{},
-			Rules:        make(map[string]RuleMetadata),
-		}, nil
+		return nil, nil
 	}
 
 	var config RulesConfig
@@ -362,6 +462,21 @@ func loadRuleMetadata(fsys fs.FS) (*RulesConfig, error) {
 		return nil, fmt.Errorf("failed to parse metadata file: %w", err)
 	}
 
+	return &config, nil
+}
+
+// loadRuleMetadata loads the _metadata.yaml file from the rules directory
+func loadRuleMetadata(fsys fs.FS) (*RulesConfig, error) {
+	config, err := readRuleMetadata(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	// If metadata file doesn't exist, return empty config
+	if config == nil {
+		config = &RulesConfig{}
+	}
+
 	// Initialize Rules map if nil
 	if config.Rules == nil {
 		config.Rules = make(map[string]RuleMetadata)
@@ -372,5 +487,62 @@ func loadRuleMetadata(fsys fs.FS) (*RulesConfig, error) {
 		config.DefaultRules = []string{}
 	}
 
-	return &config, nil
+	return config, nil
+}
+
+// loadLayeredRuleMetadata loads the rule metadata of the base layer and merges the metadata
+// of earlier layers into it. A layer overrides the fields it sets for each rule, and
+// default_rules if it sets them.
+func loadLayeredRuleMetadata(layers []TemplateLayer) (*RulesConfig, []string, error) {
+	if len(layers) == 0 {
+		return &RulesConfig{
+			DefaultRules: []string{},
+			Rules:        make(map[string]RuleMetadata),
+		}, nil, nil
+	}
+
+	config, err := loadRuleMetadata(layers[len(layers)-1].FS)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	for i := len(layers) - 2; i >= 0; i-- {
+		layer := layers[i]
+
+		overlay, err := readRuleMetadata(layer.FS)
+		if err != nil {
+			warnings = append(warnings, layerWarning(layer, ruleMetadataPath, err))
+			continue
+		}
+		if overlay == nil {
+			continue
+		}
+
+		if overlay.DefaultRules != nil {
+			config.DefaultRules = overlay.DefaultRules
+		}
+		for name, metadata := range overlay.Rules {
+			config.Rules[name] = mergeRuleMetadata(config.Rules[name], metadata)
+		}
+	}
+
+	return config, warnings, nil
+}
+
+// mergeRuleMetadata returns base with the fields set in overlay replaced.
+func mergeRuleMetadata(base RuleMetadata, overlay RuleMetadata) RuleMetadata {
+	if overlay.Name != "" {
+		base.Name = overlay.Name
+	}
+	if overlay.Description != "" {
+		base.Description = overlay.Description
+	}
+	if overlay.Filename != "" {
+		base.Filename = overlay.Filename
+	}
+	if overlay.Paths != nil {
+		base.Paths = overlay.Paths
+	}
+	return base
 }
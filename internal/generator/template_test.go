@@ -4,6 +4,7 @@ import (
 	"io/fs"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"testing/fstest"
 
@@ -1126,3 +1127,466 @@ paths: {{pathsToYAML .Paths}}
 		})
 	}
 }
+
+func TestEngine_ItemPath(t *testing.T) {
+	fsys := fstest.MapFS{
+		"prompts/rules/_metadata.yaml": &fstest.MapFile{
+			Data: []byte(`rules:
+  golang:
+    name: "Go Guidelines"
+    filename: ".claude/rules/go-guidelines.md"
+`),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		itemType ItemType
+		itemName string
+		want     string
+	}{
+		{
+			name:     "agent",
+			itemType: ItemTypeAgent,
+			itemName: "golang-engineer",
+			want:     filepath.Join("out", "golang-engineer.md"),
+		},
+		{
+			name:     "command",
+			itemType: ItemTypeCommand,
+			itemName: "feature",
+			want:     filepath.Join("out", "feature.md"),
+		},
+		{
+			name:     "skill",
+			itemType: ItemTypeSkill,
+			itemName: "coding",
+			want:     filepath.Join("out", "coding.md"),
+		},
+		{
+			name:     "rule with metadata filename",
+			itemType: ItemTypeRule,
+			itemName: "golang",
+			want:     filepath.Join("out", "go-guidelines.md"),
+		},
+		{
+			name:     "rule without metadata",
+			itemType: ItemTypeRule,
+			itemName: "common",
+			want:     filepath.Join("out", "common.md"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			engine, err := NewEngineWithFS(fsys)
+			require.NoError(t, err)
+
+			got := engine.ItemPath("out", tt.itemType, tt.itemName)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestEngine_WriteItem(t *testing.T) {
+	fsys := fstest.MapFS{
+		"prompts/agents/golang-engineer.tmpl": &fstest.MapFile{
+			Data: []byte(`Agent {{.Name}}`),
+		},
+		"prompts/skills/coding.tmpl": &fstest.MapFile{
+			Data: []byte(`Skill {{.Name}}`),
+		},
+		"prompts/rules/golang.tmpl": &fstest.MapFile{
+			Data: []byte(`Rule {{.Title}} {{range .Paths}}{{.}} {{end}}`),
+		},
+		"prompts/rules/_metadata.yaml": &fstest.MapFile{
+			Data: []byte(`rules:
+  golang:
+    name: "Go Guidelines"
+    filename: ".claude/rules/go-guidelines.md"
+    paths: ["**/*.go"]
+`),
+		},
+	}
+
+	tests := []struct {
+		name        string
+		itemType    ItemType
+		itemName    string
+		opts        WriteOptions
+		setupFiles  map[string]string
+		wantPath    string
+		wantContent string
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:        "writes agent and creates directories",
+			itemType:    ItemTypeAgent,
+			itemName:    "golang-engineer",
+			wantPath:    "golang-engineer.md",
+			wantContent: "Agent golang-engineer",
+		},
+		{
+			name:        "writes skill",
+			itemType:    ItemTypeSkill,
+			itemName:    "coding",
+			wantPath:    "coding.md",
+			wantContent: "Skill coding",
+		},
+		{
+			name:        "writes rule to metadata filename",
+			itemType:    ItemTypeRule,
+			itemName:    "golang",
+			wantPath:    "go-guidelines.md",
+			wantContent: "Rule Go Guidelines **/*.go ",
+		},
+		{
+			name:     "writes rule with options",
+			itemType: ItemTypeRule,
+			itemName: "golang",
+			opts: WriteOptions{
+				GenerateOptions: GenerateOptions{Paths: []string{"src/**/*.go"}},
+				Filename:        "custom.md",
+			},
+			wantPath:    "custom.md",
+			wantContent: "Rule Go Guidelines src/**/*.go ",
+		},
+		{
+			name:     "returns error when file exists and force is false",
+			itemType: ItemTypeAgent,
+			itemName: "golang-engineer",
+			setupFiles: map[string]string{
+				"golang-engineer.md": "existing",
+			},
+			wantErr:     true,
+			errContains: "already exists (use --force to overwrite)",
+		},
+		{
+			name:     "returns error when rule file exists and force is false",
+			itemType: ItemTypeRule,
+			itemName: "golang",
+			setupFiles: map[string]string{
+				"go-guidelines.md": "existing",
+			},
+			wantErr:     true,
+			errContains: "already exists (use --force to overwrite)",
+		},
+		{
+			name:     "overwrites existing file when force is true",
+			itemType: ItemTypeAgent,
+			itemName: "golang-engineer",
+			opts:     WriteOptions{Force: true},
+			setupFiles: map[string]string{
+				"golang-engineer.md": "existing",
+			},
+			wantPath:    "golang-engineer.md",
+			wantContent: "Agent golang-engineer",
+		},
+		{
+			name:        "returns error for unknown template",
+			itemType:    ItemTypeAgent,
+			itemName:    "non-existent",
+			wantErr:     true,
+			errContains: "failed to generate agent non-existent",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := filepath.Join(t.TempDir(), "prompts")
+			for filename, content := range tt.setupFiles {
+				path := filepath.Join(dir, filename)
+				require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+				require.NoError(t, os.WriteFile(path, []byte(content), 0644))
+			}
+
+			engine, err := NewEngineWithFS(fsys)
+			require.NoError(t, err)
+
+			got, err := engine.WriteItem(dir, tt.itemType, tt.itemName, tt.opts)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, filepath.Join(dir, tt.wantPath), got)
+
+			content, err := os.ReadFile(got)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContent, string(content))
+		})
+	}
+}
+
+func TestNewEngineWithLayers(t *testing.T) {
+	projectFS := fstest.MapFS{
+		"prompts/agents/code-reviewer.tmpl": &fstest.MapFile{
+			Data: []byte(`Project {{.Name}}`),
+		},
+		"prompts/agents/team-agent.tmpl": &fstest.MapFile{
+			Data: []byte(`Team {{.Name}}`),
+		},
+	}
+	embeddedFS := fstest.MapFS{
+		"prompts/agents/_partials.tmpl": &fstest.MapFile{
+			Data: []byte(`{{define "HEADER"}}Header{{end}}`),
+		},
+		"prompts/agents/code-reviewer.tmpl": &fstest.MapFile{
+			Data: []byte(`Embedded {{.Name}}`),
+		},
+		"prompts/agents/software-engineer.tmpl": &fstest.MapFile{
+			Data: []byte(`{{template "HEADER"}} {{.Name}}`),
+		},
+	}
+
+	engine, err := NewEngineWithLayers(
+		TemplateLayer{Source: SourceProject, FS: projectFS},
+		TemplateLayer{Source: SourceEmbedded, FS: embeddedFS},
+	)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"code-reviewer", "software-engineer", "team-agent"}, engine.List(ItemTypeAgent))
+
+	tests := []struct {
+		name        string
+		itemName    string
+		wantContent string
+		wantSource  string
+	}{
+		{
+			name:        "project template overrides embedded template",
+			itemName:    "code-reviewer",
+			wantContent: "Project code-reviewer",
+			wantSource:  SourceProject,
+		},
+		{
+			name:        "project-only template",
+			itemName:    "team-agent",
+			wantContent: "Team team-agent",
+			wantSource:  SourceProject,
+		},
+		{
+			name:        "embedded template uses embedded partials",
+			itemName:    "software-engineer",
+			wantContent: "Header software-engineer",
+			wantSource:  SourceEmbedded,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := engine.Generate(ItemTypeAgent, tt.itemName)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContent, got)
+			assert.Equal(t, tt.wantSource, engine.Source(ItemTypeAgent, tt.itemName))
+		})
+	}
+
+	t.Run("unknown template has no source", func(t *testing.T) {
+		assert.Equal(t, "", engine.Source(ItemTypeAgent, "non-existent"))
+	})
+
+	t.Run("engine from a single FS has no sources", func(t *testing.T) {
+		single, err := NewEngineWithFS(embeddedFS)
+		require.NoError(t, err)
+		assert.Equal(t, "", single.Source(ItemTypeAgent, "code-reviewer"))
+	})
+}
+
+func TestNewEngineWithLayers_Merge(t *testing.T) {
+	embeddedFS := fstest.MapFS{
+		"prompts/agents/_partials.tmpl": &fstest.MapFile{
+			Data: []byte(`{{define "HEADER"}}Header{{end}}{{define "FOOTER"}}Footer{{end}}`),
+		},
+		"prompts/agents/code-reviewer.tmpl": &fstest.MapFile{
+			Data: []byte(`{{template "HEADER"}} Embedded {{.Name}} {{template "FOOTER"}}`),
+		},
+		"prompts/rules/golang.tmpl": &fstest.MapFile{
+			Data: []byte(`Rule {{.Title}}`),
+		},
+		"prompts/rules/typescript.tmpl": &fstest.MapFile{
+			Data: []byte(`Rule {{.Title}}`),
+		},
+		"prompts/rules/_metadata.yaml": &fstest.MapFile{
+			Data: []byte(`default_rules:
+  - golang
+rules:
+  golang:
+    name: "Go Guidelines"
+    filename: ".claude/rules/golang.md"
+    paths: ["**/*.go"]
+  typescript:
+    name: "TypeScript Guidelines"
+    filename: ".claude/rules/typescript.md"
+`),
+		},
+	}
+
+	tests := []struct {
+		name            string
+		projectFS       fstest.MapFS
+		itemType        ItemType
+		itemName        string
+		wantContent     string
+		wantSource      string
+		wantDefault     []string
+		wantRules       map[string]RuleMetadata
+		wantWarnings    []string
+		wantErr         bool
+		errContains     string
+		embeddedOverlay fstest.MapFS
+	}{
+		{
+			name: "partials of a layer override only the definitions they contain",
+			projectFS: fstest.MapFS{
+				"prompts/agents/_partials.tmpl": &fstest.MapFile{
+					Data: []byte(`{{define "HEADER"}}Project header{{end}}`),
+				},
+			},
+			itemType:    ItemTypeAgent,
+			itemName:    "code-reviewer",
+			wantContent: "Project header Embedded code-reviewer Footer",
+			wantSource:  SourceEmbedded,
+		},
+		{
+			name: "malformed partials of a layer are skipped",
+			projectFS: fstest.MapFS{
+				"prompts/agents/_partials.tmpl": &fstest.MapFile{
+					Data: []byte(`{{define "HEADER"}}Project header`),
+				},
+			},
+			itemType:     ItemTypeAgent,
+			itemName:     "code-reviewer",
+			wantContent:  "Header Embedded code-reviewer Footer",
+			wantSource:   SourceEmbedded,
+			wantWarnings: []string{"skipped prompts/agents/_partials.tmpl from project templates: "},
+		},
+		{
+			name: "malformed template of a layer falls back to a later layer",
+			projectFS: fstest.MapFS{
+				"prompts/agents/code-reviewer.tmpl": &fstest.MapFile{
+					Data: []byte(`Project {{.Name`),
+				},
+				"prompts/agents/team-agent.tmpl": &fstest.MapFile{
+					Data: []byte(`Team {{if}}`),
+				},
+			},
+			itemType:    ItemTypeAgent,
+			itemName:    "code-reviewer",
+			wantContent: "Header Embedded code-reviewer Footer",
+			wantSource:  SourceEmbedded,
+			wantWarnings: []string{
+				"skipped prompts/agents/code-reviewer.tmpl from project templates: ",
+				"skipped prompts/agents/team-agent.tmpl from project templates: ",
+			},
+		},
+		{
+			name: "rule metadata of a layer is merged",
+			projectFS: fstest.MapFS{
+				"prompts/rules/_metadata.yaml": &fstest.MapFile{
+					Data: []byte(`rules:
+  golang:
+    paths: ["src/**/*.go"]
+  python:
+    name: "Python Guidelines"
+`),
+				},
+			},
+			itemType:    ItemTypeRule,
+			itemName:    "golang",
+			wantContent: "Rule Go Guidelines",
+			wantSource:  SourceEmbedded,
+			wantDefault: []string{"golang"},
+			wantRules: map[string]RuleMetadata{
+				"golang":     {Name: "Go Guidelines", Filename: ".claude/rules/golang.md", Paths: []string{"src/**/*.go"}},
+				"typescript": {Name: "TypeScript Guidelines", Filename: ".claude/rules/typescript.md"},
+				"python":     {Name: "Python Guidelines"},
+			},
+		},
+		{
+			name: "default rules of a layer replace the default rules",
+			projectFS: fstest.MapFS{
+				"prompts/rules/_metadata.yaml": &fstest.MapFile{
+					Data: []byte(`default_rules: [typescript]`),
+				},
+			},
+			itemType:    ItemTypeRule,
+			itemName:    "typescript",
+			wantContent: "Rule TypeScript Guidelines",
+			wantSource:  SourceEmbedded,
+			wantDefault: []string{"typescript"},
+		},
+		{
+			name: "malformed rule metadata of a layer is skipped",
+			projectFS: fstest.MapFS{
+				"prompts/rules/_metadata.yaml": &fstest.MapFile{
+					Data: []byte(`rules: [invalid`),
+				},
+			},
+			itemType:     ItemTypeRule,
+			itemName:     "golang",
+			wantContent:  "Rule Go Guidelines",
+			wantSource:   SourceEmbedded,
+			wantDefault:  []string{"golang"},
+			wantWarnings: []string{"skipped prompts/rules/_metadata.yaml from project templates: "},
+		},
+		{
+			name:      "malformed template of the base layer returns error",
+			projectFS: fstest.MapFS{},
+			embeddedOverlay: fstest.MapFS{
+				"prompts/agents/broken.tmpl": &fstest.MapFile{
+					Data: []byte(`Broken {{.Name`),
+				},
+			},
+			wantErr:     true,
+			errContains: "failed to parse template prompts/agents/broken.tmpl",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			baseFS := fstest.MapFS{}
+			for name, file := range embeddedFS {
+				baseFS[name] = file
+			}
+			for name, file := range tt.embeddedOverlay {
+				baseFS[name] = file
+			}
+
+			engine, err := NewEngineWithLayers(
+				TemplateLayer{Source: SourceProject, FS: tt.projectFS},
+				TemplateLayer{Source: SourceEmbedded, FS: baseFS},
+			)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+
+			got, err := engine.Generate(tt.itemType, tt.itemName)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantContent, got)
+			assert.Equal(t, tt.wantSource, engine.Source(tt.itemType, tt.itemName))
+
+			if tt.wantDefault != nil {
+				assert.Equal(t, tt.wantDefault, engine.GetDefaultRules())
+			}
+			if tt.wantRules != nil {
+				assert.Equal(t, tt.wantRules, engine.GetRulesConfig().Rules)
+			}
+
+			gotWarnings := engine.Warnings()
+			require.Len(t, gotWarnings, len(tt.wantWarnings), "warnings: %v", gotWarnings)
+			for i, want := range tt.wantWarnings {
+				assert.True(t, strings.HasPrefix(gotWarnings[i], want), "warning %q does not start with %q", gotWarnings[i], want)
+			}
+		})
+	}
+}
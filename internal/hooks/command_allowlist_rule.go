@@ -0,0 +1,330 @@
+package hooks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// commandAllowlistRule blocks Bash commands that run executables outside an allowlist.
+type commandAllowlistRule struct {
+	allowed map[string]bool
+}
+
+// NewCommandAllowlistRule creates a new rule that only allows Bash commands whose
+// executables are in allowedCommands. Executables are matched exactly, so a
+// command invoked by path (e.g. /usr/bin/go) must be listed by that path.
+func NewCommandAllowlistRule(allowedCommands []string) Rule {
+	allowed := make(map[string]bool, len(allowedCommands))
+	for _, name := range allowedCommands {
+		allowed[name] = true
+	}
+
+	return &commandAllowlistRule{
+		allowed: allowed,
+	}
+}
+
+// Name returns the unique identifier for this rule.
+func (r *commandAllowlistRule) Name() string {
+	return "command-allowlist"
+}
+
+// Description returns a human-readable description of what this rule does.
+func (r *commandAllowlistRule) Description() string {
+	return "Blocks Bash commands that run executables outside the allowlist"
+}
+
+// Evaluate checks if every sub-command of the Bash command runs an allowed executable.
+func (r *commandAllowlistRule) Evaluate(input *ToolInput) (*RuleResult, error) {
+	if input.ToolName != "Bash" {
+		return NewAllowedResult(), nil
+	}
+
+	command, ok := input.GetStringArg("command")
+	if !ok {
+		return NewAllowedResult(), nil
+	}
+
+	// Constructs whose executables cannot be determined are blocked, so they cannot bypass the allowlist
+	commands, unsupported := parseAllowlistCommands(command)
+	if unsupported != "" {
+		return NewBlockedResult(
+			r.Name(),
+			fmt.Sprintf("%s is not allowed when the command allowlist is enabled", unsupported),
+		), nil
+	}
+
+	for _, words := range commands {
+		executable, ok := extractExecutable(words)
+		if !ok {
+			continue
+		}
+
+		if !r.allowed[executable] {
+			return NewBlockedResult(
+				r.Name(),
+				fmt.Sprintf("Command %q is not in the allowed command list", executable),
+			), nil
+		}
+	}
+
+	return NewAllowedResult(), nil
+}
+
+// extractExecutable returns the executable of a simple command given as words,
+// skipping leading environment variable assignments like FOO=bar.
+// Returns false if the command only consists of assignments.
+func extractExecutable(words []string) (string, bool) {
+	for _, word := range words {
+		if isEnvAssignment(word) {
+			continue
+		}
+		return word, true
+	}
+	return "", false
+}
+
+// isEnvAssignment checks if a token is an environment variable assignment (NAME=value).
+func isEnvAssignment(token string) bool {
+	idx := strings.Index(token, "=")
+	if idx <= 0 {
+		return false
+	}
+
+	for i, ch := range token[:idx] {
+		isLetter := (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || ch == '_'
+		isDigit := ch >= '0' && ch <= '9'
+		if !isLetter && !(isDigit && i > 0) {
+			return false
+		}
+	}
+	return true
+}
+
+// Shell constructs the allowlist parser cannot evaluate. Commands using them are blocked,
+// because the executables they run cannot be determined from the command text.
+const (
+	unsupportedCommandSubstitution = "Command substitution"
+	unsupportedProcessSubstitution = "Process substitution"
+	unsupportedANSICQuoting        = "ANSI-C quoting"
+	unsupportedHereDocument        = "A here-document"
+	unsupportedUnterminatedQuote   = "An unterminated quote"
+	unsupportedTrailingBackslash   = "A trailing backslash"
+	unsupportedMissingTarget       = "A redirection without a target"
+)
+
+// allowlistParser splits a Bash command into simple commands of words after quote removal.
+// Unlike splitShellCommands, it handles backslash escapes and redirections,
+// so that it can decide which word of each simple command is the executable.
+type allowlistParser struct {
+	commands        [][]string
+	words           []string
+	word            strings.Builder
+	inWord          bool // A word has started, possibly as an empty quoted string
+	quoted          bool // The current word contains quotes or escapes
+	pendingRedirect bool // The next word is the target of a redirection
+}
+
+// parseAllowlistCommands parses a command into the words of its simple commands.
+// If the command uses a construct the parser cannot evaluate, it returns a description
+// of the construct instead.
+func parseAllowlistCommands(command string) ([][]string, string) {
+	p := &allowlistParser{}
+
+	for i := 0; i < len(command); i++ {
+		ch := command[i]
+
+		switch ch {
+		case '\\':
+			if i+1 >= len(command) {
+				return nil, unsupportedTrailingBackslash
+			}
+			i++
+			// A backslash before a newline continues the line
+			if command[i] != '\n' {
+				p.writeQuoted(command[i : i+1])
+			}
+		case '\'':
+			end := strings.IndexByte(command[i+1:], '\'')
+			if end < 0 {
+				return nil, unsupportedUnterminatedQuote
+			}
+			p.writeQuoted(command[i+1 : i+1+end])
+			i += end + 1
+		case '"':
+			end, unsupported := p.readDoubleQuoted(command, i+1)
+			if unsupported != "" {
+				return nil, unsupported
+			}
+			i = end
+		case '`':
+			return nil, unsupportedCommandSubstitution
+		case '$':
+			if i+1 < len(command) {
+				switch command[i+1] {
+				case '(':
+					return nil, unsupportedCommandSubstitution
+				case '\'':
+					return nil, unsupportedANSICQuoting
+				}
+			}
+			p.write(ch)
+		case ' ', '\t':
+			p.endWord()
+		case '\n', ';', '|', '(', ')':
+			if unsupported := p.endCommand(); unsupported != "" {
+				return nil, unsupported
+			}
+		case '&':
+			if i+1 < len(command) && command[i+1] == '>' {
+				// &> and &>> redirect both stdout and stderr
+				if unsupported := p.startRedirect(); unsupported != "" {
+					return nil, unsupported
+				}
+				i++
+				if i+1 < len(command) && command[i+1] == '>' {
+					i++
+				}
+				continue
+			}
+			if unsupported := p.endCommand(); unsupported != "" {
+				return nil, unsupported
+			}
+		case '<', '>':
+			if i+1 < len(command) && command[i+1] == '(' {
+				return nil, unsupportedProcessSubstitution
+			}
+			if ch == '<' && strings.HasPrefix(command[i:], "<<") && !strings.HasPrefix(command[i:], "<<<") {
+				return nil, unsupportedHereDocument
+			}
+			if unsupported := p.startRedirect(); unsupported != "" {
+				return nil, unsupported
+			}
+			switch {
+			case strings.HasPrefix(command[i:], "<<<"):
+				i += 2
+			case i+1 < len(command) && strings.IndexByte("<>&|", command[i+1]) >= 0:
+				// >>, >&, <&, <>, >|
+				i++
+			}
+		default:
+			p.write(ch)
+		}
+	}
+
+	if unsupported := p.endCommand(); unsupported != "" {
+		return nil, unsupported
+	}
+	return p.commands, ""
+}
+
+// readDoubleQuoted reads a double-quoted string starting after the opening quote
+// and returns the index of the closing quote.
+func (p *allowlistParser) readDoubleQuoted(command string, start int) (int, string) {
+	p.inWord = true
+	p.quoted = true
+
+	for i := start; i < len(command); i++ {
+		ch := command[i]
+
+		switch ch {
+		case '"':
+			return i, ""
+		case '\\':
+			// Inside double quotes, a backslash only escapes $, `, ", \ and newlines
+			if i+1 < len(command) && strings.IndexByte("$`\"\\\n", command[i+1]) >= 0 {
+				i++
+				if command[i] != '\n' {
+					p.word.WriteByte(command[i])
+				}
+				continue
+			}
+			p.word.WriteByte(ch)
+		case '`':
+			return 0, unsupportedCommandSubstitution
+		case '$':
+			if i+1 < len(command) && command[i+1] == '(' {
+				return 0, unsupportedCommandSubstitution
+			}
+			p.word.WriteByte(ch)
+		default:
+			p.word.WriteByte(ch)
+		}
+	}
+
+	return 0, unsupportedUnterminatedQuote
+}
+
+// write appends an unquoted character to the current word.
+func (p *allowlistParser) write(ch byte) {
+	p.inWord = true
+	p.word.WriteByte(ch)
+}
+
+// writeQuoted appends quoted or escaped text to the current word.
+func (p *allowlistParser) writeQuoted(text string) {
+	p.inWord = true
+	p.quoted = true
+	p.word.WriteString(text)
+}
+
+// endWord finishes the current word. A redirection target is dropped
+// instead of being added to the words of the command.
+func (p *allowlistParser) endWord() {
+	if !p.inWord {
+		return
+	}
+
+	if p.pendingRedirect {
+		p.pendingRedirect = false
+	} else {
+		p.words = append(p.words, p.word.String())
+	}
+	p.word.Reset()
+	p.inWord = false
+	p.quoted = false
+}
+
+// startRedirect finishes the current word before a redirection operator.
+// An unquoted number right before the operator is a file descriptor like the 2 in 2>&1.
+func (p *allowlistParser) startRedirect() string {
+	if p.inWord && !p.quoted && isDigits(p.word.String()) {
+		p.word.Reset()
+		p.inWord = false
+	} else {
+		p.endWord()
+	}
+
+	if p.pendingRedirect {
+		return unsupportedMissingTarget
+	}
+	p.pendingRedirect = true
+	return ""
+}
+
+// endCommand finishes the current simple command.
+func (p *allowlistParser) endCommand() string {
+	p.endWord()
+	if p.pendingRedirect {
+		return unsupportedMissingTarget
+	}
+
+	if len(p.words) > 0 {
+		p.commands = append(p.commands, p.words)
+		p.words = nil
+	}
+	return ""
+}
+
+// isDigits checks if s is a non-empty string of ASCII digits.
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,387 @@
+package hooks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCommandAllowlistRule(t *testing.T) {
+	rule := NewCommandAllowlistRule([]string{"go"})
+	assert.NotNil(t, rule)
+	assert.Equal(t, "command-allowlist", rule.Name())
+	assert.Equal(t, "Blocks Bash commands that run executables outside the allowlist", rule.Description())
+}
+
+func TestCommandAllowlistRule_Evaluate(t *testing.T) {
+	allowed := []string{"go", "git", "make", "npm", "ls"}
+
+	tests := []struct {
+		name        string
+		toolName    string
+		command     string
+		wantAllowed bool
+		wantMessage string
+	}{
+		{
+			name:        "allow non-Bash tool",
+			toolName:    "Write",
+			command:     "rm -rf /",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow Bash with no command argument",
+			toolName:    "Bash",
+			command:     "",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow allowlisted command",
+			toolName:    "Bash",
+			command:     "go test ./...",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow chained allowlisted commands",
+			toolName:    "Bash",
+			command:     "go build ./... && go vet ./... | ls; make test",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow allowlisted command with env assignments",
+			toolName:    "Bash",
+			command:     "CGO_ENABLED=0 GOOS=linux go build ./...",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow allowlisted command in subshell with redirection",
+			toolName:    "Bash",
+			command:     "(go test ./... 2>&1)",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow command substitution syntax inside single quotes",
+			toolName:    "Bash",
+			command:     "git commit -m 'use $(pwd) here'",
+			wantAllowed: true,
+		},
+		{
+			name:        "block command not in allowlist",
+			toolName:    "Bash",
+			command:     "curl https://example.com",
+			wantAllowed: false,
+			wantMessage: `Command "curl" is not in the allowed command list`,
+		},
+		{
+			name:        "block disallowed command chained after allowed one",
+			toolName:    "Bash",
+			command:     "go build ./... && rm -rf build",
+			wantAllowed: false,
+			wantMessage: `Command "rm" is not in the allowed command list`,
+		},
+		{
+			name:        "block disallowed command piped from allowed one",
+			toolName:    "Bash",
+			command:     "ls | xargs rm",
+			wantAllowed: false,
+			wantMessage: `Command "xargs" is not in the allowed command list`,
+		},
+		{
+			name:        "block disallowed command on a new line",
+			toolName:    "Bash",
+			command:     "go build ./...\nrm -rf build",
+			wantAllowed: false,
+			wantMessage: `Command "rm" is not in the allowed command list`,
+		},
+		{
+			name:        "block allowlisted name invoked by path",
+			toolName:    "Bash",
+			command:     "./go build",
+			wantAllowed: false,
+			wantMessage: `Command "./go" is not in the allowed command list`,
+		},
+		{
+			name:        "block command substitution",
+			toolName:    "Bash",
+			command:     "go build $(rm -rf /)",
+			wantAllowed: false,
+			wantMessage: "Command substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block command substitution in double quotes",
+			toolName:    "Bash",
+			command:     `git commit -m "it's $(whoami)"`,
+			wantAllowed: false,
+			wantMessage: "Command substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block backtick substitution",
+			toolName:    "Bash",
+			command:     "go build `rm -rf /`",
+			wantAllowed: false,
+			wantMessage: "Command substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "allow escaped characters in arguments",
+			toolName:    "Bash",
+			command:     `ls my\ dir \; \|`,
+			wantAllowed: true,
+		},
+		{
+			name:        "allow escaped quotes inside double quotes",
+			toolName:    "Bash",
+			command:     `git commit -m "say \"hi\"; done"`,
+			wantAllowed: true,
+		},
+		{
+			name:        "allow line continuation",
+			toolName:    "Bash",
+			command:     "go build \\\n  ./...",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow redirection targets that look like commands",
+			toolName:    "Bash",
+			command:     "go test ./... > rm 2>&1 &>> curl < wget",
+			wantAllowed: true,
+		},
+		{
+			name:        "allow here-string",
+			toolName:    "Bash",
+			command:     "go run . <<< rm",
+			wantAllowed: true,
+		},
+		{
+			name:        "block escaped quote followed by another command",
+			toolName:    "Bash",
+			command:     `ls \"; rm -rf /tmp/x; echo \"`,
+			wantAllowed: false,
+			wantMessage: `Command "rm" is not in the allowed command list`,
+		},
+		{
+			name:        "block escaped executable name",
+			toolName:    "Bash",
+			command:     `r\m -rf /tmp/x`,
+			wantAllowed: false,
+			wantMessage: `Command "rm" is not in the allowed command list`,
+		},
+		{
+			name:        "block command after leading redirection",
+			toolName:    "Bash",
+			command:     "> out.txt rm -rf /tmp/x",
+			wantAllowed: false,
+			wantMessage: `Command "rm" is not in the allowed command list`,
+		},
+		{
+			name:        "block empty quoted executable",
+			toolName:    "Bash",
+			command:     `"" ls`,
+			wantAllowed: false,
+			wantMessage: `Command "" is not in the allowed command list`,
+		},
+		{
+			name:        "block command substitution after escaped single quote",
+			toolName:    "Bash",
+			command:     `ls \'$(rm -rf /tmp/x)\'`,
+			wantAllowed: false,
+			wantMessage: "Command substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block backtick substitution in double quotes",
+			toolName:    "Bash",
+			command:     "ls \"`rm -rf /tmp/x`\"",
+			wantAllowed: false,
+			wantMessage: "Command substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block output process substitution",
+			toolName:    "Bash",
+			command:     "ls >(rm -rf /tmp/x)",
+			wantAllowed: false,
+			wantMessage: "Process substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block input process substitution",
+			toolName:    "Bash",
+			command:     "ls <(curl evil)",
+			wantAllowed: false,
+			wantMessage: "Process substitution is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block ANSI-C quoting",
+			toolName:    "Bash",
+			command:     `$'\x72m' -rf /tmp/x`,
+			wantAllowed: false,
+			wantMessage: "ANSI-C quoting is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block here-document",
+			toolName:    "Bash",
+			command:     "go run . <<EOF\nrm -rf /tmp/x\nEOF",
+			wantAllowed: false,
+			wantMessage: "A here-document is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block unterminated quote",
+			toolName:    "Bash",
+			command:     `ls "; rm -rf /tmp/x`,
+			wantAllowed: false,
+			wantMessage: "An unterminated quote is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block trailing backslash",
+			toolName:    "Bash",
+			command:     `ls \`,
+			wantAllowed: false,
+			wantMessage: "A trailing backslash is not allowed when the command allowlist is enabled",
+		},
+		{
+			name:        "block redirection without a target",
+			toolName:    "Bash",
+			command:     "ls >; rm -rf /tmp/x",
+			wantAllowed: false,
+			wantMessage: "A redirection without a target is not allowed when the command allowlist is enabled",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewCommandAllowlistRule(allowed)
+
+			jsonInput := `{"tool_name": "` + tt.toolName + `", "tool_input": {}}`
+			if tt.command != "" {
+				jsonInput = `{"tool_name": "` + tt.toolName + `", "tool_input": {"command": "` + escapeJSON(tt.command) + `"}}`
+			}
+			toolInput, err := ParseToolInput(strings.NewReader(jsonInput))
+			require.NoError(t, err)
+
+			got, err := rule.Evaluate(toolInput)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, got.Allowed)
+
+			if !tt.wantAllowed {
+				assert.Equal(t, "command-allowlist", got.RuleName)
+				assert.Equal(t, tt.wantMessage, got.Message)
+			}
+		})
+	}
+}
+
+func TestExtractExecutable(t *testing.T) {
+	tests := []struct {
+		name   string
+		words  []string
+		want   string
+		wantOK bool
+	}{
+		{
+			name:   "simple command",
+			words:  []string{"go", "test", "./..."},
+			want:   "go",
+			wantOK: true,
+		},
+		{
+			name:   "skips env assignments",
+			words:  []string{"FOO=bar", "BAZ_1=qux", "make", "build"},
+			want:   "make",
+			wantOK: true,
+		},
+		{
+			name:   "only env assignments",
+			words:  []string{"FOO=bar"},
+			wantOK: false,
+		},
+		{
+			name:   "no words",
+			words:  nil,
+			wantOK: false,
+		},
+		{
+			name:   "argument with equals is not an assignment",
+			words:  []string{"1FOO=bar", "go"},
+			want:   "1FOO=bar",
+			wantOK: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := extractExecutable(tt.words)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParseAllowlistCommands(t *testing.T) {
+	tests := []struct {
+		name            string
+		command         string
+		want            [][]string
+		wantUnsupported string
+	}{
+		{
+			name:    "splits on control operators",
+			command: "go build && go vet || make; ls | npm test & git status",
+			want: [][]string{
+				{"go", "build"},
+				{"go", "vet"},
+				{"make"},
+				{"ls"},
+				{"npm", "test"},
+				{"git", "status"},
+			},
+		},
+		{
+			name:    "removes quotes and escapes",
+			command: `git commit -m "a \"b\" \$c" 'd\e' f\ g`,
+			want: [][]string{
+				{"git", "commit", "-m", `a "b" $c`, `d\e`, "f g"},
+			},
+		},
+		{
+			name:    "drops redirections and their targets",
+			command: "go test 2>&1 >out.txt 1>>log &>all <in <<<str >&2",
+			want: [][]string{
+				{"go", "test"},
+			},
+		},
+		{
+			name:    "keeps quoted number before redirection",
+			command: `ls "2">out`,
+			want: [][]string{
+				{"ls", "2"},
+			},
+		},
+		{
+			name:            "process substitution",
+			command:         "diff <(ls) <(ls -a)",
+			wantUnsupported: "Process substitution",
+		},
+		{
+			name:            "command substitution in double quotes",
+			command:         `ls "$(rm -rf /tmp/x)"`,
+			wantUnsupported: "Command substitution",
+		},
+		{
+			name:            "unterminated single quote",
+			command:         "ls 'foo",
+			wantUnsupported: "An unterminated quote",
+		},
+		{
+			name:            "redirection followed by redirection",
+			command:         "ls > > out",
+			wantUnsupported: "A redirection without a target",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotUnsupported := parseAllowlistCommands(tt.command)
+			assert.Equal(t, tt.wantUnsupported, gotUnsupported)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
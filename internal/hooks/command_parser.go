@@ -46,6 +46,28 @@ func isProtectedBranch(branch string) bool {
 	return false
 }
 
+// isProtectedBranchWithExtras checks if a branch name is main, master, or one of extraBranches.
+// Full ref paths like refs/heads/develop or origin/develop are matched as well.
+func isProtectedBranchWithExtras(branch string, extraBranches []string) bool {
+	if isProtectedBranch(branch) {
+		return true
+	}
+
+	branch = strings.TrimSpace(branch)
+	for _, extra := range extraBranches {
+		if branch == extra || strings.HasSuffix(branch, "/"+extra) {
+			return true
+		}
+	}
+	return false
+}
+
+// protectedBranchesLabel returns the protected branch names joined for use in messages,
+// e.g. "main/master" or "main/master/develop".
+func protectedBranchesLabel(extraBranches []string) string {
+	return strings.Join(append([]string{"main", "master"}, extraBranches...), "/")
+}
+
 // parseCommandTokens parses a command string into tokens, respecting quoted strings.
 // Quotes are included in the returned tokens to preserve the original token structure.
 func parseCommandTokens(command string) []string {
@@ -294,6 +294,64 @@ func TestIsProtectedBranch(t *testing.T) {
 	}
 }
 
+func TestIsProtectedBranchWithExtras(t *testing.T) {
+	tests := []struct {
+		name          string
+		branch        string
+		extraBranches []string
+		want          bool
+	}{
+		{
+			name:          "main is protected without extras",
+			branch:        "main",
+			extraBranches: nil,
+			want:          true,
+		},
+		{
+			name:          "extra branch is protected",
+			branch:        "develop",
+			extraBranches: []string{"develop"},
+			want:          true,
+		},
+		{
+			name:          "extra branch full ref path is protected",
+			branch:        "refs/heads/release/v1",
+			extraBranches: []string{"release/v1"},
+			want:          true,
+		},
+		{
+			name:          "extra branch with remote prefix is protected",
+			branch:        "origin/develop",
+			extraBranches: []string{"develop"},
+			want:          true,
+		},
+		{
+			name:          "branch containing extra name is not protected",
+			branch:        "feature-develop",
+			extraBranches: []string{"develop"},
+			want:          false,
+		},
+		{
+			name:          "feature branch is not protected",
+			branch:        "feature/test",
+			extraBranches: []string{"develop"},
+			want:          false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isProtectedBranchWithExtras(tt.branch, tt.extraBranches)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestProtectedBranchesLabel(t *testing.T) {
+	assert.Equal(t, "main/master", protectedBranchesLabel(nil))
+	assert.Equal(t, "main/master/develop/release", protectedBranchesLabel([]string{"develop", "release"}))
+}
+
 func TestFindNonFlagArgs(t *testing.T) {
 	tests := []struct {
 		name            string
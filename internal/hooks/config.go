@@ -0,0 +1,295 @@
+package hooks
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"sort"
+
+	"github.com/michael-freling/claude-code-tools/internal/command"
+	"gopkg.in/yaml.v3"
+)
+
+// DefaultConfigPath is the default location of the hooks configuration file, relative to the project root.
+const DefaultConfigPath = ".claude/hooks.yaml"
+
+// Severity controls how a rule violation is handled.
+type Severity string
+
+const (
+	// SeverityBlock blocks the tool usage when the rule is violated.
+	SeverityBlock Severity = "block"
+	// SeverityWarn allows the tool usage but reports the violation.
+	SeverityWarn Severity = "warn"
+)
+
+// RuleConfig holds the settings of a single rule.
+type RuleConfig struct {
+	Enabled           *bool             `yaml:"enabled"`            // Whether the rule is evaluated (default depends on the rule)
+	Severity          Severity          `yaml:"severity"`           // block or warn (default: block)
+	ProtectedBranches []string          `yaml:"protected_branches"` // Extra protected branches besides main/master (git-push, gh-pr-merge)
+	AllowedCommands   []string          `yaml:"allowed_commands"`   // Executables allowed by command-allowlist
+	SecretPatterns    map[string]string `yaml:"secret_patterns"`    // Extra secret regexes keyed by pattern name (secret-detection)
+}
+
+// PatternRuleConfig defines a user rule that blocks tool usage whose arguments match a pattern.
+type PatternRuleConfig struct {
+	Name     string   `yaml:"name"`     // Unique rule name reported when the rule blocks
+	Tools    []string `yaml:"tools"`    // Tool names the rule applies to, e.g. Bash or Write (default: all tools)
+	Fields   []string `yaml:"fields"`   // Tool input arguments to match, e.g. command or file_path
+	Regex    string   `yaml:"regex"`    // Regular expression matched against the fields
+	Glob     string   `yaml:"glob"`     // Glob pattern matched against the fields (supports **)
+	Message  string   `yaml:"message"`  // Message shown when the rule blocks
+	Severity Severity `yaml:"severity"` // block or warn (default: block)
+}
+
+// Config holds the hooks configuration.
+// Rules are keyed by rule name, e.g. "git-push" or "no-verify".
+type Config struct {
+	Rules        map[string]RuleConfig `yaml:"rules"`         // Settings for each built-in rule
+	PatternRules []PatternRuleConfig   `yaml:"pattern_rules"` // User-defined pattern rules, evaluated after built-in rules
+}
+
+// ruleDefinition describes a built-in rule and how to build it from its settings.
+type ruleDefinition struct {
+	name             string
+	enabledByDefault bool
+	settings         []string // Rule-specific settings the rule uses, besides enabled and severity
+	build            func(settings RuleConfig) (Rule, error)
+}
+
+// LoadConfig loads the hooks configuration from a YAML file.
+// Returns an empty configuration if the file does not exist or is empty.
+// Returns an error if the file has keys that are not known settings.
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return &Config{
+				Rules: make(map[string]RuleConfig),
+			}, nil
+		}
+		return nil, fmt.Errorf("failed to read config file %s: %w", path, err)
+	}
+
+	// Unknown keys are errors so that a misspelled setting does not silently fall back to the defaults
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	var config Config
+	if err := decoder.Decode(&config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+
+	if config.Rules == nil {
+		config.Rules = make(map[string]RuleConfig)
+	}
+
+	return &config, nil
+}
+
+// NewRulesFromConfig builds the enabled built-in rules in evaluation order, followed by pattern rules.
+// Rules with warn severity are wrapped so that violations do not block the tool usage.
+// Returns an error if the configuration references an unknown rule or has invalid settings.
+func NewRulesFromConfig(config *Config, gitRunner command.GitRunner, ghRunner command.GhRunner) ([]Rule, error) {
+	definitions := builtinRuleDefinitions(gitRunner, ghRunner)
+
+	known := make(map[string]bool, len(definitions))
+	for _, definition := range definitions {
+		known[definition.name] = true
+	}
+
+	var unknown []string
+	for name := range config.Rules {
+		if !known[name] {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		sort.Strings(unknown)
+		return nil, fmt.Errorf("unknown rules in config: %v", unknown)
+	}
+
+	var rules []Rule
+	for _, definition := range definitions {
+		settings := config.Rules[definition.name]
+		if unused := unusedSettings(settings, definition.settings); len(unused) > 0 {
+			return nil, fmt.Errorf("settings %v are not used by rule %s", unused, definition.name)
+		}
+
+		enabled := definition.enabledByDefault
+		if settings.Enabled != nil {
+			enabled = *settings.Enabled
+		}
+		if !enabled {
+			continue
+		}
+
+		rule, err := definition.build(settings)
+		if err != nil {
+			return nil, fmt.Errorf("invalid settings for rule %s: %w", definition.name, err)
+		}
+
+		rule, err = applySeverity(rule, settings.Severity)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	for i, patternConfig := range config.PatternRules {
+		if known[patternConfig.Name] {
+			return nil, fmt.Errorf("duplicate rule name %q in pattern_rules", patternConfig.Name)
+		}
+
+		rule, err := NewPatternRule(patternConfig)
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern rule at index %d: %w", i, err)
+		}
+		known[patternConfig.Name] = true
+
+		rule, err = applySeverity(rule, patternConfig.Severity)
+		if err != nil {
+			return nil, err
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules, nil
+}
+
+// unusedSettings returns the names of the rule-specific settings that are set but not in the used list.
+func unusedSettings(settings RuleConfig, used []string) []string {
+	set := map[string]bool{
+		"protected_branches": len(settings.ProtectedBranches) > 0,
+		"allowed_commands":   len(settings.AllowedCommands) > 0,
+		"secret_patterns":    len(settings.SecretPatterns) > 0,
+	}
+	for _, name := range used {
+		delete(set, name)
+	}
+
+	var unused []string
+	for name, isSet := range set {
+		if isSet {
+			unused = append(unused, name)
+		}
+	}
+	sort.Strings(unused)
+	return unused
+}
+
+// applySeverity wraps the rule according to its configured severity.
+func applySeverity(rule Rule, severity Severity) (Rule, error) {
+	switch severity {
+	case "", SeverityBlock:
+		return rule, nil
+	case SeverityWarn:
+		return NewWarnOnlyRule(rule), nil
+	default:
+		return nil, fmt.Errorf("invalid severity %q for rule %s (must be %q or %q)", severity, rule.Name(), SeverityBlock, SeverityWarn)
+	}
+}
+
+// builtinRuleDefinitions returns the built-in rules in evaluation order.
+func builtinRuleDefinitions(gitRunner command.GitRunner, ghRunner command.GhRunner) []ruleDefinition {
+	return []ruleDefinition{
+		{
+			name:             "no-verify",
+			enabledByDefault: true,
+			build: func(_ RuleConfig) (Rule, error) {
+				return NewNoVerifyRule(), nil
+			},
+		},
+		{
+			name:             "git-push",
+			enabledByDefault: true,
+			settings:         []string{"protected_branches"},
+			build: func(settings RuleConfig) (Rule, error) {
+				return NewGitPushRule(gitRunner, settings.ProtectedBranches...), nil
+			},
+		},
+		{
+			name:             "gh-branch-protection",
+			enabledByDefault: true,
+			build: func(_ RuleConfig) (Rule, error) {
+				return NewBranchProtectionRule(), nil
+			},
+		},
+		{
+			name:             "gh-ruleset",
+			enabledByDefault: true,
+			build: func(_ RuleConfig) (Rule, error) {
+				return NewRulesetRule(), nil
+			},
+		},
+		{
+			name:             "gh-pr-merge",
+			enabledByDefault: true,
+			settings:         []string{"protected_branches"},
+			build: func(settings RuleConfig) (Rule, error) {
+				return NewPRMergeRule(ghRunner, settings.ProtectedBranches...), nil
+			},
+		},
+		{
+			name:             "secret-detection",
+			enabledByDefault: false,
+			settings:         []string{"secret_patterns"},
+			build: func(settings RuleConfig) (Rule, error) {
+				return NewSecretDetectionRule(settings.SecretPatterns)
+			},
+		},
+		{
+			name:             "command-allowlist",
+			enabledByDefault: false,
+			settings:         []string{"allowed_commands"},
+			build: func(settings RuleConfig) (Rule, error) {
+				if len(settings.AllowedCommands) == 0 {
+					return nil, fmt.Errorf("allowed_commands cannot be empty")
+				}
+				return NewCommandAllowlistRule(settings.AllowedCommands), nil
+			},
+		},
+	}
+}
+
+// warnOnlyRule wraps a rule so that its violations are reported as warnings instead of blocking.
+type warnOnlyRule struct {
+	rule Rule
+}
+
+// NewWarnOnlyRule creates a rule that reports violations of the given rule as warnings.
+func NewWarnOnlyRule(rule Rule) Rule {
+	return &warnOnlyRule{
+		rule: rule,
+	}
+}
+
+// Name returns the unique identifier of the wrapped rule.
+func (r *warnOnlyRule) Name() string {
+	return r.rule.Name()
+}
+
+// Description returns the description of the wrapped rule.
+func (r *warnOnlyRule) Description() string {
+	return r.rule.Description()
+}
+
+// Evaluate evaluates the wrapped rule and converts a blocked result into a warning.
+func (r *warnOnlyRule) Evaluate(input *ToolInput) (*RuleResult, error) {
+	result, err := r.rule.Evaluate(input)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Allowed {
+		return NewWarningResult(result.RuleName, result.Message), nil
+	}
+
+	return result, nil
+}
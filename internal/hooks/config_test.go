@@ -0,0 +1,467 @@
+package hooks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/michael-freling/claude-code-tools/internal/command"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLoadConfig(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	tests := []struct {
+		name        string
+		content     string
+		noFile      bool
+		want        *Config
+		wantErr     bool
+		errContains string
+	}{
+		{
+			name:   "missing file returns empty config",
+			noFile: true,
+			want: &Config{
+				Rules: map[string]RuleConfig{},
+			},
+		},
+		{
+			name:    "empty file returns empty config",
+			content: "",
+			want: &Config{
+				Rules: map[string]RuleConfig{},
+			},
+		},
+		{
+			name: "parses rule settings",
+			content: `rules:
+  no-verify:
+    enabled: false
+  git-push:
+    severity: warn
+    protected_branches:
+      - develop
+  command-allowlist:
+    enabled: true
+    allowed_commands: [go, git]
+`,
+			want: &Config{
+				Rules: map[string]RuleConfig{
+					"no-verify": {
+						Enabled: &disabled,
+					},
+					"git-push": {
+						Severity:          SeverityWarn,
+						ProtectedBranches: []string{"develop"},
+					},
+					"command-allowlist": {
+						Enabled:         &enabled,
+						AllowedCommands: []string{"go", "git"},
+					},
+				},
+			},
+		},
+		{
+			name:        "invalid YAML returns error",
+			content:     "rules: [invalid",
+			wantErr:     true,
+			errContains: "failed to parse config file",
+		},
+		{
+			name: "unknown rule setting returns error",
+			content: `rules:
+  git-push:
+    protected_branch: [develop]
+`,
+			wantErr:     true,
+			errContains: "field protected_branch not found",
+		},
+		{
+			name: "unknown top-level key returns error",
+			content: `rule:
+  secret-detection:
+    enabled: true
+`,
+			wantErr:     true,
+			errContains: "field rule not found",
+		},
+		{
+			name:    "comment-only file returns empty config",
+			content: "# no settings\n",
+			want: &Config{
+				Rules: map[string]RuleConfig{},
+			},
+		},
+		{
+			name: "parses pattern rules",
+			content: `pattern_rules:
+  - name: no-env-files
+    tools: [Read, Edit]
+    fields: [file_path]
+    glob: "**/*.env"
+    message: Do not touch env files
+    severity: warn
+`,
+			want: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{
+						Name:     "no-env-files",
+						Tools:    []string{"Read", "Edit"},
+						Fields:   []string{"file_path"},
+						Glob:     "**/*.env",
+						Message:  "Do not touch env files",
+						Severity: SeverityWarn,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "hooks.yaml")
+			if !tt.noFile {
+				require.NoError(t, os.WriteFile(path, []byte(tt.content), 0644))
+			}
+
+			got, err := LoadConfig(path)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNewRulesFromConfig(t *testing.T) {
+	enabled := true
+	disabled := false
+
+	tests := []struct {
+		name          string
+		config        *Config
+		wantRuleNames []string
+		wantErr       bool
+		errContains   string
+	}{
+		{
+			name:          "empty config enables default rules",
+			config:        &Config{Rules: map[string]RuleConfig{}},
+			wantRuleNames: []string{"no-verify", "git-push", "gh-branch-protection", "gh-ruleset", "gh-pr-merge"},
+		},
+		{
+			name: "disabled rules are skipped",
+			config: &Config{Rules: map[string]RuleConfig{
+				"no-verify":  {Enabled: &disabled},
+				"gh-ruleset": {Enabled: &disabled},
+			}},
+			wantRuleNames: []string{"git-push", "gh-branch-protection", "gh-pr-merge"},
+		},
+		{
+			name: "command-allowlist is appended when enabled",
+			config: &Config{Rules: map[string]RuleConfig{
+				"command-allowlist": {Enabled: &enabled, AllowedCommands: []string{"go"}},
+			}},
+			wantRuleNames: []string{"no-verify", "git-push", "gh-branch-protection", "gh-ruleset", "gh-pr-merge", "command-allowlist"},
+		},
+		{
+			name: "secret-detection is appended when enabled",
+			config: &Config{Rules: map[string]RuleConfig{
+				"secret-detection": {Enabled: &enabled},
+			}},
+			wantRuleNames: []string{"no-verify", "git-push", "gh-branch-protection", "gh-ruleset", "gh-pr-merge", "secret-detection"},
+		},
+		{
+			name: "command-allowlist without allowed commands returns error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"command-allowlist": {Enabled: &enabled},
+			}},
+			wantErr:     true,
+			errContains: "invalid settings for rule command-allowlist: allowed_commands cannot be empty",
+		},
+		{
+			name: "invalid secret pattern returns error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"secret-detection": {Enabled: &enabled, SecretPatterns: map[string]string{"broken": "("}},
+			}},
+			wantErr:     true,
+			errContains: "invalid settings for rule secret-detection: invalid regex for secret pattern broken",
+		},
+		{
+			name: "unknown rule returns error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"git-pull": {},
+				"git-psuh": {},
+			}},
+			wantErr:     true,
+			errContains: "unknown rules in config: [git-psuh git-pull]",
+		},
+		{
+			name: "settings not used by the rule return error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"no-verify": {ProtectedBranches: []string{"develop"}},
+			}},
+			wantErr:     true,
+			errContains: "settings [protected_branches] are not used by rule no-verify",
+		},
+		{
+			name: "settings of another rule return error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"git-push": {
+					ProtectedBranches: []string{"develop"},
+					AllowedCommands:   []string{"go"},
+				},
+			}},
+			wantErr:     true,
+			errContains: "settings [allowed_commands] are not used by rule git-push",
+		},
+		{
+			name: "invalid severity returns error",
+			config: &Config{Rules: map[string]RuleConfig{
+				"no-verify": {Severity: "error"},
+			}},
+			wantErr:     true,
+			errContains: `invalid severity "error" for rule no-verify`,
+		},
+		{
+			name: "pattern rules are appended after built-in rules",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Fields: []string{"command"}, Regex: "curl"},
+					{Name: "no-env-files", Fields: []string{"file_path"}, Glob: "**/*.env", Severity: SeverityWarn},
+				},
+			},
+			wantRuleNames: []string{"no-verify", "git-push", "gh-branch-protection", "gh-ruleset", "gh-pr-merge", "no-curl", "no-env-files"},
+		},
+		{
+			name: "pattern rule with built-in name returns error",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "git-push", Fields: []string{"command"}, Regex: "push"},
+				},
+			},
+			wantErr:     true,
+			errContains: `duplicate rule name "git-push" in pattern_rules`,
+		},
+		{
+			name: "duplicate pattern rule names return error",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Fields: []string{"command"}, Regex: "curl"},
+					{Name: "no-curl", Fields: []string{"command"}, Regex: "wget"},
+				},
+			},
+			wantErr:     true,
+			errContains: `duplicate rule name "no-curl" in pattern_rules`,
+		},
+		{
+			name: "invalid pattern rule returns error",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Regex: "curl"},
+				},
+			},
+			wantErr:     true,
+			errContains: "invalid pattern rule at index 0: fields cannot be empty",
+		},
+		{
+			name: "invalid pattern rule severity returns error",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Fields: []string{"command"}, Regex: "curl", Severity: "error"},
+				},
+			},
+			wantErr:     true,
+			errContains: `invalid severity "error" for rule no-curl`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGit := command.NewMockGitRunner(ctrl)
+			mockGh := command.NewMockGhRunner(ctrl)
+
+			got, err := NewRulesFromConfig(tt.config, mockGit, mockGh)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			gotRuleNames := make([]string, 0, len(got))
+			for _, rule := range got {
+				gotRuleNames = append(gotRuleNames, rule.Name())
+			}
+			assert.Equal(t, tt.wantRuleNames, gotRuleNames)
+		})
+	}
+}
+
+func TestNewRulesFromConfig_Settings(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      *Config
+		command     string
+		wantAllowed bool
+		wantWarning bool
+		wantMessage string
+	}{
+		{
+			name: "protected branches are passed to git-push",
+			config: &Config{Rules: map[string]RuleConfig{
+				"git-push": {ProtectedBranches: []string{"develop"}},
+			}},
+			command:     "git push origin develop",
+			wantAllowed: false,
+			wantMessage: "Direct push to main/master/develop branch is not allowed",
+		},
+		{
+			name: "warn severity reports a warning instead of blocking",
+			config: &Config{Rules: map[string]RuleConfig{
+				"no-verify": {Severity: SeverityWarn},
+			}},
+			command:     "git commit --no-verify -m 'test'",
+			wantAllowed: true,
+			wantWarning: true,
+			wantMessage: "Command contains --no-verify flag which bypasses git hooks",
+		},
+		{
+			name: "block severity blocks",
+			config: &Config{Rules: map[string]RuleConfig{
+				"no-verify": {Severity: SeverityBlock},
+			}},
+			command:     "git commit --no-verify -m 'test'",
+			wantAllowed: false,
+			wantMessage: "Command contains --no-verify flag which bypasses git hooks",
+		},
+		{
+			name: "pattern rule blocks matching command",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Tools: []string{"Bash"}, Fields: []string{"command"}, Regex: `\bcurl\b`, Message: "curl is not allowed"},
+				},
+			},
+			command:     "curl https://example.com",
+			wantAllowed: false,
+			wantMessage: "curl is not allowed",
+		},
+		{
+			name: "warn pattern rule reports a warning",
+			config: &Config{
+				Rules: map[string]RuleConfig{},
+				PatternRules: []PatternRuleConfig{
+					{Name: "no-curl", Fields: []string{"command"}, Regex: `\bcurl\b`, Message: "curl is not allowed", Severity: SeverityWarn},
+				},
+			},
+			command:     "curl https://example.com",
+			wantAllowed: true,
+			wantWarning: true,
+			wantMessage: "curl is not allowed",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGit := command.NewMockGitRunner(ctrl)
+			mockGh := command.NewMockGhRunner(ctrl)
+
+			rules, err := NewRulesFromConfig(tt.config, mockGit, mockGh)
+			require.NoError(t, err)
+
+			jsonInput := `{"tool_name": "Bash", "tool_input": {"command": "` + escapeJSON(tt.command) + `"}}`
+			toolInput, err := ParseToolInput(strings.NewReader(jsonInput))
+			require.NoError(t, err)
+
+			got, err := NewRuleEngine(rules...).Evaluate(toolInput)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, got.Allowed)
+
+			if tt.wantWarning {
+				require.Len(t, got.Warnings, 1)
+				assert.Equal(t, tt.wantMessage, got.Warnings[0].Message)
+				return
+			}
+			if !tt.wantAllowed {
+				assert.Equal(t, tt.wantMessage, got.Message)
+			}
+		})
+	}
+}
+
+func TestWarnOnlyRule(t *testing.T) {
+	tests := []struct {
+		name    string
+		rule    *mockRule
+		want    *RuleResult
+		wantErr bool
+	}{
+		{
+			name: "blocked result becomes warning",
+			rule: &mockRule{
+				name:   "rule1",
+				result: NewBlockedResult("rule1", "blocked by rule1"),
+			},
+			want: NewWarningResult("rule1", "blocked by rule1"),
+		},
+		{
+			name: "allowed result is unchanged",
+			rule: &mockRule{
+				name:   "rule1",
+				result: NewAllowedResult(),
+			},
+			want: NewAllowedResult(),
+		},
+		{
+			name: "error is returned",
+			rule: &mockRule{
+				name: "rule1",
+				err:  assert.AnError,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := NewWarnOnlyRule(tt.rule)
+			assert.Equal(t, tt.rule.Name(), rule.Name())
+			assert.Equal(t, tt.rule.Description(), rule.Description())
+
+			got, err := rule.Evaluate(&ToolInput{ToolName: "Bash"})
+
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
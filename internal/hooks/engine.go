@@ -16,11 +16,13 @@ func NewRuleEngine(rules ...Rule) *ruleEngine {
 
 // Evaluate evaluates all rules against the tool input.
 // Returns the first blocking result, or an allowed result if no rules block.
+// Warnings from warn-only rules are collected in the allowed result.
 func (e *ruleEngine) Evaluate(input *ToolInput) (*RuleResult, error) {
 	if input == nil {
 		return nil, fmt.Errorf("input cannot be nil")
 	}
 
+	var warnings []*RuleResult
 	for _, rule := range e.rules {
 		result, err := rule.Evaluate(input)
 		if err != nil {
@@ -30,7 +32,12 @@ func (e *ruleEngine) Evaluate(input *ToolInput) (*RuleResult, error) {
 		if !result.Allowed {
 			return result, nil
 		}
+		if result.Warning {
+			warnings = append(warnings, result)
+		}
 	}
 
-	return NewAllowedResult(), nil
+	allowed := NewAllowedResult()
+	allowed.Warnings = warnings
+	return allowed, nil
 }
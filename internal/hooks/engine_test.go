@@ -143,6 +143,46 @@ func TestRuleEngine_Evaluate(t *testing.T) {
 			input: &ToolInput{ToolName: "Test"},
 			want:  NewBlockedResult("rule1", "blocked by rule1"),
 		},
+		{
+			name: "warnings are collected in allowed result",
+			rules: []Rule{
+				&mockRule{
+					name:   "rule1",
+					result: NewWarningResult("rule1", "warned by rule1"),
+				},
+				&mockRule{
+					name:   "rule2",
+					result: NewAllowedResult(),
+				},
+				&mockRule{
+					name:   "rule3",
+					result: NewWarningResult("rule3", "warned by rule3"),
+				},
+			},
+			input: &ToolInput{ToolName: "Test"},
+			want: &RuleResult{
+				Allowed: true,
+				Warnings: []*RuleResult{
+					NewWarningResult("rule1", "warned by rule1"),
+					NewWarningResult("rule3", "warned by rule3"),
+				},
+			},
+		},
+		{
+			name: "blocking rule after warning returns blocked",
+			rules: []Rule{
+				&mockRule{
+					name:   "rule1",
+					result: NewWarningResult("rule1", "warned by rule1"),
+				},
+				&mockRule{
+					name:   "rule2",
+					result: NewBlockedResult("rule2", "blocked by rule2"),
+				},
+			},
+			input: &ToolInput{ToolName: "Test"},
+			want:  NewBlockedResult("rule2", "blocked by rule2"),
+		},
 		{
 			name: "rule error returns error",
 			rules: []Rule{
@@ -74,3 +74,38 @@ func (t *ToolInput) GetBoolArg(name string) (bool, bool) {
 
 	return boolValue, true
 }
+
+// GetStringArgsFromArray retrieves a string field from each object of an array argument,
+// such as new_string of each edit in the edits argument of MultiEdit.
+// Objects without the string field are skipped. Returns nil and false if the array argument is not found.
+func (t *ToolInput) GetStringArgsFromArray(arrayName string, field string) ([]string, bool) {
+	if t.parsed == nil {
+		return nil, false
+	}
+
+	value, ok := t.parsed[arrayName]
+	if !ok {
+		return nil, false
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		object, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		strValue, ok := object[field].(string)
+		if !ok {
+			continue
+		}
+		values = append(values, strValue)
+	}
+
+	return values, true
+}
@@ -199,3 +199,72 @@ func TestToolInput_GetBoolArg(t *testing.T) {
 		})
 	}
 }
+
+func TestToolInput_GetStringArgsFromArray(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		arrayName string
+		field     string
+		wantValue []string
+		wantOk    bool
+	}{
+		{
+			name:      "string fields of objects",
+			input:     `{"tool_name": "MultiEdit", "tool_input": {"edits": [{"old_string": "a", "new_string": "b"}, {"old_string": "c", "new_string": "d"}]}}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantValue: []string{"b", "d"},
+			wantOk:    true,
+		},
+		{
+			name:      "skips objects without the string field",
+			input:     `{"tool_name": "MultiEdit", "tool_input": {"edits": [{"old_string": "a"}, {"new_string": 1}, "text", {"new_string": "d"}]}}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantValue: []string{"d"},
+			wantOk:    true,
+		},
+		{
+			name:      "empty array",
+			input:     `{"tool_name": "MultiEdit", "tool_input": {"edits": []}}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantValue: []string{},
+			wantOk:    true,
+		},
+		{
+			name:      "non-array argument",
+			input:     `{"tool_name": "MultiEdit", "tool_input": {"edits": "text"}}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantOk:    false,
+		},
+		{
+			name:      "non-existent argument",
+			input:     `{"tool_name": "MultiEdit", "tool_input": {}}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantOk:    false,
+		},
+		{
+			name:      "nil tool_input",
+			input:     `{"tool_name": "MultiEdit"}`,
+			arrayName: "edits",
+			field:     "new_string",
+			wantOk:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reader := strings.NewReader(tt.input)
+			toolInput, err := ParseToolInput(reader)
+			require.NoError(t, err)
+
+			gotValue, gotOk := toolInput.GetStringArgsFromArray(tt.arrayName, tt.field)
+			assert.Equal(t, tt.wantValue, gotValue)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
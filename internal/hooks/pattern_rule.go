@@ -0,0 +1,126 @@
+package hooks
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// patternRule blocks tool usage whose arguments match a user-defined pattern.
+type patternRule struct {
+	name      string
+	toolNames map[string]bool
+	fields    []string
+	pattern   *regexp.Regexp
+	message   string
+}
+
+// NewPatternRule creates a new rule from a user-defined pattern rule configuration.
+// Exactly one of Regex or Glob must be set. Returns an error if the configuration is invalid.
+func NewPatternRule(config PatternRuleConfig) (Rule, error) {
+	if config.Name == "" {
+		return nil, fmt.Errorf("name cannot be empty")
+	}
+	if len(config.Fields) == 0 {
+		return nil, fmt.Errorf("fields cannot be empty")
+	}
+	if (config.Regex == "") == (config.Glob == "") {
+		return nil, fmt.Errorf("exactly one of regex or glob must be set")
+	}
+
+	expr := config.Regex
+	if config.Glob != "" {
+		expr = globToRegexp(config.Glob)
+	}
+
+	pattern, err := regexp.Compile(expr)
+	if err != nil {
+		if config.Glob != "" {
+			return nil, fmt.Errorf("invalid glob %q: %w", config.Glob, err)
+		}
+		return nil, fmt.Errorf("invalid regex %q: %w", config.Regex, err)
+	}
+
+	toolNames := make(map[string]bool, len(config.Tools))
+	for _, tool := range config.Tools {
+		toolNames[tool] = true
+	}
+
+	message := config.Message
+	if message == "" {
+		message = fmt.Sprintf("Tool input matches the pattern of rule %s", config.Name)
+	}
+
+	return &patternRule{
+		name:      config.Name,
+		toolNames: toolNames,
+		fields:    config.Fields,
+		pattern:   pattern,
+		message:   message,
+	}, nil
+}
+
+// Name returns the unique identifier for this rule.
+func (r *patternRule) Name() string {
+	return r.name
+}
+
+// Description returns a human-readable description of what this rule does.
+func (r *patternRule) Description() string {
+	return "Blocks tool usage whose arguments match a user-defined pattern"
+}
+
+// Evaluate checks if any configured argument of the tool input matches the pattern.
+func (r *patternRule) Evaluate(input *ToolInput) (*RuleResult, error) {
+	if len(r.toolNames) > 0 && !r.toolNames[input.ToolName] {
+		return NewAllowedResult(), nil
+	}
+
+	for _, field := range r.fields {
+		value, ok := input.GetStringArg(field)
+		if !ok {
+			continue
+		}
+
+		if r.pattern.MatchString(value) {
+			return NewBlockedResult(r.Name(), r.message), nil
+		}
+	}
+
+	return NewAllowedResult(), nil
+}
+
+// globToRegexp converts a glob pattern into an anchored regular expression.
+// "**" matches across directories, "*" and "?" match within a single path segment.
+// Tools pass absolute file paths, so a glob not starting with "/" matches at any directory,
+// like a pattern in .gitignore. For example: "secrets/*.json" matches "secrets/a.json"
+// and "/repo/secrets/a.json", but "/secrets/*.json" only matches "/secrets/a.json".
+func globToRegexp(glob string) string {
+	var result strings.Builder
+	result.WriteString("^")
+	if !strings.HasPrefix(glob, "/") && !strings.HasPrefix(glob, "**") {
+		result.WriteString("(.*/)?")
+	}
+
+	for i := 0; i < len(glob); i++ {
+		ch := glob[i]
+
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			result.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			result.WriteString(".*")
+			i++
+		case ch == '*':
+			result.WriteString("[^/]*")
+		case ch == '?':
+			result.WriteString("[^/]")
+		default:
+			result.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+
+	result.WriteString("$")
+	return result.String()
+}
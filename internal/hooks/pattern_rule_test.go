@@ -0,0 +1,293 @@
+package hooks
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewPatternRule(t *testing.T) {
+	tests := []struct {
+		name            string
+		config          PatternRuleConfig
+		wantDescription string
+		wantErr         bool
+		errContains     string
+	}{
+		{
+			name: "regex rule",
+			config: PatternRuleConfig{
+				Name:   "no-curl",
+				Fields: []string{"command"},
+				Regex:  `\bcurl\b`,
+			},
+			wantDescription: "Blocks tool usage whose arguments match a user-defined pattern",
+		},
+		{
+			name: "glob rule",
+			config: PatternRuleConfig{
+				Name:   "no-env-files",
+				Fields: []string{"file_path"},
+				Glob:   "**/*.env",
+			},
+			wantDescription: "Blocks tool usage whose arguments match a user-defined pattern",
+		},
+		{
+			name: "empty name returns error",
+			config: PatternRuleConfig{
+				Fields: []string{"command"},
+				Regex:  "curl",
+			},
+			wantErr:     true,
+			errContains: "name cannot be empty",
+		},
+		{
+			name: "empty fields returns error",
+			config: PatternRuleConfig{
+				Name:  "no-curl",
+				Regex: "curl",
+			},
+			wantErr:     true,
+			errContains: "fields cannot be empty",
+		},
+		{
+			name: "neither regex nor glob returns error",
+			config: PatternRuleConfig{
+				Name:   "no-curl",
+				Fields: []string{"command"},
+			},
+			wantErr:     true,
+			errContains: "exactly one of regex or glob must be set",
+		},
+		{
+			name: "both regex and glob returns error",
+			config: PatternRuleConfig{
+				Name:   "no-curl",
+				Fields: []string{"command"},
+				Regex:  "curl",
+				Glob:   "curl*",
+			},
+			wantErr:     true,
+			errContains: "exactly one of regex or glob must be set",
+		},
+		{
+			name: "invalid regex returns error",
+			config: PatternRuleConfig{
+				Name:   "broken",
+				Fields: []string{"command"},
+				Regex:  "curl(",
+			},
+			wantErr:     true,
+			errContains: `invalid regex "curl("`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPatternRule(tt.config)
+
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.config.Name, got.Name())
+			assert.Equal(t, tt.wantDescription, got.Description())
+		})
+	}
+}
+
+func TestPatternRule_Evaluate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      PatternRuleConfig
+		jsonInput   string
+		wantAllowed bool
+		wantMessage string
+	}{
+		{
+			name: "block Bash command matching regex",
+			config: PatternRuleConfig{
+				Name:    "no-curl",
+				Tools:   []string{"Bash"},
+				Fields:  []string{"command"},
+				Regex:   `\bcurl\b`,
+				Message: "Use the WebFetch tool instead of curl",
+			},
+			jsonInput:   `{"tool_name": "Bash", "tool_input": {"command": "curl https://example.com"}}`,
+			wantAllowed: false,
+			wantMessage: "Use the WebFetch tool instead of curl",
+		},
+		{
+			name: "allow Bash command not matching regex",
+			config: PatternRuleConfig{
+				Name:   "no-curl",
+				Tools:  []string{"Bash"},
+				Fields: []string{"command"},
+				Regex:  `\bcurl\b`,
+			},
+			jsonInput:   `{"tool_name": "Bash", "tool_input": {"command": "go test ./..."}}`,
+			wantAllowed: true,
+		},
+		{
+			name: "allow tool not in the tool filter",
+			config: PatternRuleConfig{
+				Name:   "no-curl",
+				Tools:  []string{"Bash"},
+				Fields: []string{"command"},
+				Regex:  `\bcurl\b`,
+			},
+			jsonInput:   `{"tool_name": "Write", "tool_input": {"command": "curl https://example.com"}}`,
+			wantAllowed: true,
+		},
+		{
+			name: "empty tool filter applies to all tools",
+			config: PatternRuleConfig{
+				Name:   "no-env-files",
+				Fields: []string{"file_path"},
+				Glob:   "**/*.env",
+			},
+			jsonInput:   `{"tool_name": "Edit", "tool_input": {"file_path": "/repo/config/prod.env"}}`,
+			wantAllowed: false,
+			wantMessage: "Tool input matches the pattern of rule no-env-files",
+		},
+		{
+			name: "block when any of the fields matches",
+			config: PatternRuleConfig{
+				Name:   "no-secrets-dir",
+				Tools:  []string{"Read", "Grep"},
+				Fields: []string{"file_path", "path"},
+				Glob:   "secrets/**",
+			},
+			jsonInput:   `{"tool_name": "Grep", "tool_input": {"path": "secrets/prod/key.pem"}}`,
+			wantAllowed: false,
+			wantMessage: "Tool input matches the pattern of rule no-secrets-dir",
+		},
+		{
+			name: "block relative glob matching absolute file path",
+			config: PatternRuleConfig{
+				Name:   "no-secret-json",
+				Tools:  []string{"Write"},
+				Fields: []string{"file_path"},
+				Glob:   "secrets/*.json",
+			},
+			jsonInput:   `{"tool_name": "Write", "tool_input": {"file_path": "/repo/secrets/a.json"}}`,
+			wantAllowed: false,
+			wantMessage: "Tool input matches the pattern of rule no-secret-json",
+		},
+		{
+			name: "allow glob not matching",
+			config: PatternRuleConfig{
+				Name:   "no-env-files",
+				Fields: []string{"file_path"},
+				Glob:   "**/*.env",
+			},
+			jsonInput:   `{"tool_name": "Write", "tool_input": {"file_path": "/repo/main.go"}}`,
+			wantAllowed: true,
+		},
+		{
+			name: "allow when field is missing",
+			config: PatternRuleConfig{
+				Name:   "no-env-files",
+				Fields: []string{"file_path"},
+				Glob:   "**",
+			},
+			jsonInput:   `{"tool_name": "Bash", "tool_input": {"command": "ls"}}`,
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewPatternRule(tt.config)
+			require.NoError(t, err)
+
+			toolInput, err := ParseToolInput(strings.NewReader(tt.jsonInput))
+			require.NoError(t, err)
+
+			got, err := rule.Evaluate(toolInput)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, got.Allowed)
+
+			if !tt.wantAllowed {
+				assert.Equal(t, tt.config.Name, got.RuleName)
+				assert.Equal(t, tt.wantMessage, got.Message)
+			}
+		})
+	}
+}
+
+func TestGlobToRegexp(t *testing.T) {
+	tests := []struct {
+		name      string
+		glob      string
+		matches   []string
+		noMatches []string
+	}{
+		{
+			name:      "single star stays within a path segment",
+			glob:      "*.env",
+			matches:   []string{".env", "prod.env", "config/prod.env", "/repo/config/prod.env"},
+			noMatches: []string{"prod.env.bak", "/repo/prod.env/key"},
+		},
+		{
+			name:      "relative glob matches absolute paths",
+			glob:      "secrets/*.json",
+			matches:   []string{"secrets/a.json", "/repo/secrets/a.json", "/repo/app/secrets/a.json"},
+			noMatches: []string{"/repo/secrets/a/b.json", "/repo/mysecrets/a.json", "/repo/secrets/a.json.bak"},
+		},
+		{
+			name:      "leading slash anchors at the root",
+			glob:      "/etc/*.conf",
+			matches:   []string{"/etc/hosts.conf"},
+			noMatches: []string{"/repo/etc/hosts.conf", "etc/hosts.conf"},
+		},
+		{
+			name:      "double star prefix matches any directory",
+			glob:      "**/*.env",
+			matches:   []string{".env", "config/prod.env", "/abs/path/.env"},
+			noMatches: []string{"prod.envrc"},
+		},
+		{
+			name:      "double star suffix matches everything below",
+			glob:      "secrets/**",
+			matches:   []string{"secrets/key.pem", "secrets/a/b/c", "/repo/secrets/key.pem"},
+			noMatches: []string{"/repo/secrets", "/repo/mysecrets/key.pem"},
+		},
+		{
+			name:      "question mark matches a single character",
+			glob:      "file?.txt",
+			matches:   []string{"file1.txt", "/repo/file1.txt"},
+			noMatches: []string{"file12.txt", "file/.txt"},
+		},
+		{
+			name:      "regexp metacharacters are literal",
+			glob:      "a+b(c).txt",
+			matches:   []string{"a+b(c).txt", "/repo/a+b(c).txt"},
+			noMatches: []string{"aab(c).txt", "a+bc.txt"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule, err := NewPatternRule(PatternRuleConfig{
+				Name:   "test",
+				Fields: []string{"file_path"},
+				Glob:   tt.glob,
+			})
+			require.NoError(t, err)
+			pattern := rule.(*patternRule).pattern
+
+			for _, path := range tt.matches {
+				assert.True(t, pattern.MatchString(path), "expected %q to match %q", tt.glob, path)
+			}
+			for _, path := range tt.noMatches {
+				assert.False(t, pattern.MatchString(path), "expected %q not to match %q", tt.glob, path)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package hooks
 
 import (
 	"context"
+	"fmt"
 	"regexp"
 	"strings"
 
@@ -16,13 +17,16 @@ var (
 
 // prMergeRule blocks PR merge commands to main/master branches.
 type prMergeRule struct {
-	ghRunner command.GhRunner
+	ghRunner               command.GhRunner
+	extraProtectedBranches []string
 }
 
 // NewPRMergeRule creates a new rule that blocks PR merges to main/master branches.
-func NewPRMergeRule(ghRunner command.GhRunner) Rule {
+// extraProtectedBranches are protected in addition to main and master.
+func NewPRMergeRule(ghRunner command.GhRunner, extraProtectedBranches ...string) Rule {
 	return &prMergeRule{
-		ghRunner: ghRunner,
+		ghRunner:               ghRunner,
+		extraProtectedBranches: extraProtectedBranches,
 	}
 }
 
@@ -60,10 +64,10 @@ func (r *prMergeRule) Evaluate(input *ToolInput) (*RuleResult, error) {
 		return NewAllowedResult(), nil
 	}
 
-	if isProtectedBranch(baseBranch) {
+	if isProtectedBranchWithExtras(baseBranch, r.extraProtectedBranches) {
 		return NewBlockedResult(
 			r.Name(),
-			"Merging PR to main/master branch is not allowed",
+			fmt.Sprintf("Merging PR to %s branch is not allowed", protectedBranchesLabel(r.extraProtectedBranches)),
 		), nil
 	}
 
@@ -540,3 +540,59 @@ func TestPRMergeRule_Evaluate_ApiMergeVariants(t *testing.T) {
 		})
 	}
 }
+
+func TestPRMergeRule_Evaluate_ExtraProtectedBranches(t *testing.T) {
+	tests := []struct {
+		name        string
+		command     string
+		prNumber    string
+		baseBranch  string
+		wantAllowed bool
+	}{
+		{
+			name:        "block merge to extra protected branch",
+			command:     "gh pr merge 123",
+			prNumber:    "123",
+			baseBranch:  "develop",
+			wantAllowed: false,
+		},
+		{
+			name:        "block merge to main with extras configured",
+			command:     "gh pr merge 456 --squash",
+			prNumber:    "456",
+			baseBranch:  "main",
+			wantAllowed: false,
+		},
+		{
+			name:        "allow merge to feature branch",
+			command:     "gh pr merge 789",
+			prNumber:    "789",
+			baseBranch:  "feature/develop-docs",
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGh := command.NewMockGhRunner(ctrl)
+			mockGh.EXPECT().GetPRBaseBranch(context.Background(), "", tt.prNumber).Return(tt.baseBranch, nil)
+			rule := NewPRMergeRule(mockGh, "develop")
+
+			jsonInput := `{"tool_name": "Bash", "tool_input": {"command": "` + escapeJSON(tt.command) + `"}}`
+			reader := strings.NewReader(jsonInput)
+			toolInput, err := ParseToolInput(reader)
+			require.NoError(t, err)
+
+			got, err := rule.Evaluate(toolInput)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, got.Allowed)
+			if !tt.wantAllowed {
+				assert.Equal(t, "gh-pr-merge", got.RuleName)
+				assert.Equal(t, "Merging PR to main/master/develop branch is not allowed", got.Message)
+			}
+		})
+	}
+}
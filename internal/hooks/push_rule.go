@@ -2,6 +2,7 @@ package hooks
 
 import (
 	"context"
+	"fmt"
 	"strings"
 
 	"github.com/michael-freling/claude-code-tools/internal/command"
@@ -11,13 +12,16 @@ const gitCommandArgsStartIndex = 2 // Skip "git" and subcommand
 
 // gitPushRule blocks git push commands to main/master branches.
 type gitPushRule struct {
-	gitRunner command.GitRunner
+	gitRunner              command.GitRunner
+	extraProtectedBranches []string
 }
 
 // NewGitPushRule creates a new rule that blocks pushes to main/master branches.
-func NewGitPushRule(gitRunner command.GitRunner) Rule {
+// extraProtectedBranches are protected in addition to main and master.
+func NewGitPushRule(gitRunner command.GitRunner, extraProtectedBranches ...string) Rule {
 	return &gitPushRule{
-		gitRunner: gitRunner,
+		gitRunner:              gitRunner,
+		extraProtectedBranches: extraProtectedBranches,
 	}
 }
 
@@ -57,6 +61,16 @@ func (r *gitPushRule) Evaluate(input *ToolInput) (*RuleResult, error) {
 	return NewAllowedResult(), nil
 }
 
+// isProtected checks if a branch is main/master or one of the extra protected branches.
+func (r *gitPushRule) isProtected(branch string) bool {
+	return isProtectedBranchWithExtras(branch, r.extraProtectedBranches)
+}
+
+// blockedMessage formats a blocked message for the given action, e.g. "Direct push to".
+func (r *gitPushRule) blockedMessage(action string) string {
+	return fmt.Sprintf("%s %s branch is not allowed", action, protectedBranchesLabel(r.extraProtectedBranches))
+}
+
 // evaluateSingleCommand checks if a single command (not chained) is a blocked git push.
 func (r *gitPushRule) evaluateSingleCommand(command string) *RuleResult {
 	command = strings.TrimSpace(command)
@@ -86,10 +100,10 @@ func (r *gitPushRule) evaluateSingleCommand(command string) *RuleResult {
 	}
 
 	// Check for explicit branch name
-	if isExplicitPushToProtectedBranch(command) {
+	if isExplicitPushToProtectedBranch(command, r.extraProtectedBranches) {
 		return NewBlockedResult(
 			r.Name(),
-			"Direct push to main/master branch is not allowed",
+			r.blockedMessage("Direct push to"),
 		)
 	}
 
@@ -102,10 +116,10 @@ func (r *gitPushRule) evaluateSingleCommand(command string) *RuleResult {
 			return nil
 		}
 
-		if isProtectedBranch(currentBranch) {
+		if r.isProtected(currentBranch) {
 			return NewBlockedResult(
 				r.Name(),
-				"Direct push to main/master branch is not allowed",
+				r.blockedMessage("Direct push to"),
 			)
 		}
 	}
@@ -121,10 +135,10 @@ func (r *gitPushRule) checkDeleteOperation(args []string) *RuleResult {
 	// Check for --delete or -d flag with protected branch
 	if containsDeleteFlag(args) {
 		for _, arg := range nonFlagArgs {
-			if isProtectedBranch(arg) {
+			if r.isProtected(arg) {
 				return NewBlockedResult(
 					r.Name(),
-					"Deleting main/master branch is not allowed",
+					r.blockedMessage("Deleting"),
 				)
 			}
 		}
@@ -134,10 +148,10 @@ func (r *gitPushRule) checkDeleteOperation(args []string) *RuleResult {
 	for _, arg := range nonFlagArgs {
 		if isDeleteRefspec(arg) {
 			target := extractTargetFromRefspec(arg)
-			if isProtectedBranch(target) {
+			if r.isProtected(target) {
 				return NewBlockedResult(
 					r.Name(),
-					"Deleting main/master branch is not allowed",
+					r.blockedMessage("Deleting"),
 				)
 			}
 		}
@@ -160,16 +174,16 @@ func (r *gitPushRule) checkRefspecPush(args []string) *RuleResult {
 		// Check if this is a refspec (contains : or starts with +)
 		if strings.Contains(arg, ":") || isForcePushRefspec(arg) {
 			target := extractTargetFromRefspec(arg)
-			if isProtectedBranch(target) {
+			if r.isProtected(target) {
 				if isForcePushRefspec(arg) {
 					return NewBlockedResult(
 						r.Name(),
-						"Force push to main/master branch is not allowed",
+						r.blockedMessage("Force push to"),
 					)
 				}
 				return NewBlockedResult(
 					r.Name(),
-					"Direct push to main/master branch is not allowed",
+					r.blockedMessage("Direct push to"),
 				)
 			}
 		}
@@ -178,8 +192,9 @@ func (r *gitPushRule) checkRefspecPush(args []string) *RuleResult {
 	return nil
 }
 
-// isExplicitPushToProtectedBranch checks if the command explicitly pushes to main/master.
-func isExplicitPushToProtectedBranch(command string) bool {
+// isExplicitPushToProtectedBranch checks if the command explicitly pushes to main/master
+// or one of extraBranches.
+func isExplicitPushToProtectedBranch(command string, extraBranches []string) bool {
 	args := parseGitPushArgs(command)
 
 	flagsWithValues := []string{"--repo", "--exec", "--receive-pack"}
@@ -190,7 +205,7 @@ func isExplicitPushToProtectedBranch(command string) bool {
 	}
 
 	lastNonFlagArg := nonFlagArgs[len(nonFlagArgs)-1]
-	return isProtectedBranch(lastNonFlagArg)
+	return isProtectedBranchWithExtras(lastNonFlagArg, extraBranches)
 }
 
 // isImplicitPush checks if the command is a git push without a branch specified.
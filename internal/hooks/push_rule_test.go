@@ -1110,3 +1110,76 @@ func TestGitPushRule_Evaluate_FullRefPathBypass(t *testing.T) {
 		})
 	}
 }
+
+func TestGitPushRule_Evaluate_ExtraProtectedBranches(t *testing.T) {
+	tests := []struct {
+		name          string
+		command       string
+		currentBranch string
+		wantAllowed   bool
+		wantMessage   string
+	}{
+		{
+			name:        "block explicit push to extra protected branch",
+			command:     "git push origin develop",
+			wantAllowed: false,
+			wantMessage: "Direct push to main/master/develop/release branch is not allowed",
+		},
+		{
+			name:        "block force push refspec to extra protected branch",
+			command:     "git push origin +feature:release",
+			wantAllowed: false,
+			wantMessage: "Force push to main/master/develop/release branch is not allowed",
+		},
+		{
+			name:        "block deleting extra protected branch",
+			command:     "git push origin --delete develop",
+			wantAllowed: false,
+			wantMessage: "Deleting main/master/develop/release branch is not allowed",
+		},
+		{
+			name:          "block implicit push on extra protected branch",
+			command:       "git push",
+			currentBranch: "release",
+			wantAllowed:   false,
+			wantMessage:   "Direct push to main/master/develop/release branch is not allowed",
+		},
+		{
+			name:        "block explicit push to main with extras configured",
+			command:     "git push origin main",
+			wantAllowed: false,
+			wantMessage: "Direct push to main/master/develop/release branch is not allowed",
+		},
+		{
+			name:        "allow push to feature branch",
+			command:     "git push origin feature/develop-docs",
+			wantAllowed: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			mockGit := command.NewMockGitRunner(ctrl)
+			if tt.currentBranch != "" {
+				mockGit.EXPECT().GetCurrentBranch(context.Background(), "").Return(tt.currentBranch, nil)
+			}
+			rule := NewGitPushRule(mockGit, "develop", "release")
+
+			jsonInput := `{"tool_name": "Bash", "tool_input": {"command": "` + escapeJSON(tt.command) + `"}}`
+			reader := strings.NewReader(jsonInput)
+			toolInput, err := ParseToolInput(reader)
+			require.NoError(t, err)
+
+			got, err := rule.Evaluate(toolInput)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantAllowed, got.Allowed)
+			if !tt.wantAllowed {
+				assert.Equal(t, "git-push", got.RuleName)
+				assert.Equal(t, tt.wantMessage, got.Message)
+			}
+		})
+	}
+}
@@ -11,6 +11,14 @@ type RuleResult struct {
 
 	// RuleName identifies which rule produced this result.
 	RuleName string
+
+	// Warning indicates the rule was violated but is configured to only warn,
+	// so the tool usage is still allowed.
+	Warning bool
+
+	// Warnings collects the warning results of all evaluated rules.
+	// It is only set on the result returned by the rule engine.
+	Warnings []*RuleResult
 }
 
 // NewAllowedResult creates a result that allows the tool usage.
@@ -30,3 +38,13 @@ func NewBlockedResult(ruleName, message string) *RuleResult {
 		RuleName: ruleName,
 	}
 }
+
+// NewWarningResult creates a result that allows the tool usage but reports a rule violation.
+func NewWarningResult(ruleName, message string) *RuleResult {
+	return &RuleResult{
+		Allowed:  true,
+		Message:  message,
+		RuleName: ruleName,
+		Warning:  true,
+	}
+}
@@ -75,3 +75,14 @@ func TestNewBlockedResult(t *testing.T) {
 		})
 	}
 }
+
+func TestNewWarningResult(t *testing.T) {
+	got := NewWarningResult("test-rule", "test warning")
+	want := &RuleResult{
+		Allowed:  true,
+		Message:  "test warning",
+		RuleName: "test-rule",
+		Warning:  true,
+	}
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,126 @@
+package hooks
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// secretPattern is a named regular expression that matches a kind of secret.
+type secretPattern struct {
+	name    string
+	pattern *regexp.Regexp
+}
+
+// builtinSecretPatterns are the secret formats detected by default.
+var builtinSecretPatterns = []secretPattern{
+	{name: "aws-access-key-id", pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{name: "aws-secret-access-key", pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?[A-Za-z0-9/+=]{40}`)},
+	{name: "private-key", pattern: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )?PRIVATE KEY(?: BLOCK)?-----`)},
+	{name: "github-token", pattern: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{82})\b`)},
+	{name: "slack-token", pattern: regexp.MustCompile(`\bxox[abprs]-[A-Za-z0-9-]{10,}`)},
+	{name: "google-api-key", pattern: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}`)},
+	{name: "stripe-secret-key", pattern: regexp.MustCompile(`\b[rs]k_live_[0-9A-Za-z]{24,}`)},
+}
+
+// secretContentField is an argument of a file editing tool holding new content.
+// If array is set, field is read from each object of that array argument.
+type secretContentField struct {
+	array string
+	field string
+}
+
+// secretContentFields maps each file editing tool to the arguments holding the new content.
+var secretContentFields = map[string][]secretContentField{
+	"Write":        {{field: "content"}},
+	"Edit":         {{field: "new_string"}},
+	"MultiEdit":    {{array: "edits", field: "new_string"}},
+	"NotebookEdit": {{field: "new_source"}},
+}
+
+// secretDetectionRule blocks file editing tool usage whose content contains a secret.
+type secretDetectionRule struct {
+	patterns []secretPattern
+}
+
+// NewSecretDetectionRule creates a new rule that blocks writing content that looks like a secret.
+// extraPatterns maps a pattern name to a regular expression and is checked after the built-in patterns.
+// Returns an error if an extra pattern is invalid or reuses a built-in pattern name.
+func NewSecretDetectionRule(extraPatterns map[string]string) (Rule, error) {
+	patterns := make([]secretPattern, 0, len(builtinSecretPatterns)+len(extraPatterns))
+	patterns = append(patterns, builtinSecretPatterns...)
+
+	builtinNames := make(map[string]bool, len(builtinSecretPatterns))
+	for _, pattern := range builtinSecretPatterns {
+		builtinNames[pattern.name] = true
+	}
+
+	names := make([]string, 0, len(extraPatterns))
+	for name := range extraPatterns {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if builtinNames[name] {
+			return nil, fmt.Errorf("secret pattern %s is already defined", name)
+		}
+
+		pattern, err := regexp.Compile(extraPatterns[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid regex for secret pattern %s: %w", name, err)
+		}
+		patterns = append(patterns, secretPattern{name: name, pattern: pattern})
+	}
+
+	return &secretDetectionRule{
+		patterns: patterns,
+	}, nil
+}
+
+// Name returns the unique identifier for this rule.
+func (r *secretDetectionRule) Name() string {
+	return "secret-detection"
+}
+
+// Description returns a human-readable description of what this rule does.
+func (r *secretDetectionRule) Description() string {
+	return "Blocks Write, Edit, MultiEdit, and NotebookEdit tool usage whose content contains API keys, credentials, or private keys"
+}
+
+// Evaluate checks if the content written by the tool matches any secret pattern.
+func (r *secretDetectionRule) Evaluate(input *ToolInput) (*RuleResult, error) {
+	fields, ok := secretContentFields[input.ToolName]
+	if !ok {
+		return NewAllowedResult(), nil
+	}
+
+	for _, field := range fields {
+		for _, content := range secretContents(input, field) {
+			for _, pattern := range r.patterns {
+				if pattern.pattern.MatchString(content) {
+					return NewBlockedResult(
+						r.Name(),
+						fmt.Sprintf("Content contains a possible secret matching pattern %s", pattern.name),
+					), nil
+				}
+			}
+		}
+	}
+
+	return NewAllowedResult(), nil
+}
+
+// secretContents returns the content held by a field of the tool input.
+func secretContents(input *ToolInput, field secretContentField) []string {
+	if field.array != "" {
+		contents, _ := input.GetStringArgsFromArray(field.array, field.field)
+		return contents
+	}
+
+	content, ok := input.GetStringArg(field.field)
+	if !ok {
+		return nil
+	}
+	return []string{content}
+}